@@ -0,0 +1,176 @@
+/*
+ * File: match.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package assignment
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/anonyindian/random-go"
+)
+
+// Match function is used to draw a random one-to-one assignment of n givers to n receivers,
+// where giver i may only get receiver j if allowed(i, j) is true.
+// The givers are assigned in random order, each to a random receiver, backtracking over the choices which would leave
+// the rest without a complete assignment. Dead ends are found by keeping a complete assignment of the remaining
+// givers, so the draw takes polynomial time even when the constraints are tight.
+// r (type *rand.Rand) is the generator used, nil uses random.Default().
+// It returns the receiver of every giver in a slice of type []int and any write error encountered,
+// ErrImpossible if no assignment satisfies allowed.
+// example: assignment.Match(3, func(i, j int) bool { return i != j }, nil), returns a derangement like [2 0 1].
+func Match(n int, allowed func(i, j int) bool, r *rand.Rand) ([]int, error) {
+	const fn = "Match"
+	if n < 0 {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: n must not be negative", random.ErrInvalid)}
+	}
+	if r == nil {
+		r = random.Default()
+	}
+	m := &matching{
+		adj:   make([][]int, n),
+		to:    make([]int, n),
+		from:  make([]int, n),
+		fixed: make([]bool, n),
+	}
+	for i := 0; i < n; i++ {
+		for j := 0; j < n; j++ {
+			if allowed(i, j) {
+				m.adj[i] = append(m.adj[i], j)
+			}
+		}
+		if len(m.adj[i]) == 0 {
+			return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: giver %d can't get any receiver", ErrImpossible, i)}
+		}
+		m.to[i], m.from[i] = -1, -1
+	}
+	for i := 0; i < n; i++ {
+		if !m.augment(i, make([]bool, n)) {
+			return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: no complete assignment exists, giver %d is left without a receiver", ErrImpossible, i)}
+		}
+	}
+	for _, i := range r.Perm(n) {
+		candidates := append([]int(nil), m.adj[i]...)
+		r.Shuffle(len(candidates), func(a, b int) {
+			candidates[a], candidates[b] = candidates[b], candidates[a]
+		})
+		for _, j := range candidates {
+			// The receiver currently assigned to i is always a valid choice, so the loop always ends with a choice.
+			if m.move(i, j) {
+				break
+			}
+		}
+		m.fixed[i] = true
+	}
+	return m.to, nil
+}
+
+// SecretSanta function is used to draw a secret santa, everyone of people gives a present to someone else.
+// exclusions are pairs of people who can't draw each other, in either direction, like spouses.
+// r (type *rand.Rand) is the generator used, nil uses random.Default().
+// It returns the receiver of every giver in a map of type map[string]string and any write error encountered,
+// ErrImpossible if the exclusions leave no valid draw.
+// example: assignment.SecretSanta([]string{"ann", "bob", "cid", "dan"}, [][2]string{{"ann", "bob"}}, nil), returns a draw where ann and bob don't draw each other.
+func SecretSanta(people []string, exclusions [][2]string, r *rand.Rand) (map[string]string, error) {
+	const fn = "SecretSanta"
+	index := make(map[string]int, len(people))
+	for i, p := range people {
+		if _, ok := index[p]; ok {
+			return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: %q is listed twice", random.ErrInvalid, p)}
+		}
+		index[p] = i
+	}
+	excluded := make(map[[2]int]bool, 2*len(exclusions))
+	for _, e := range exclusions {
+		a, okA := index[e[0]]
+		b, okB := index[e[1]]
+		if !okA || !okB {
+			return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: exclusion %q-%q names someone who isn't in people", random.ErrInvalid, e[0], e[1])}
+		}
+		excluded[[2]int{a, b}] = true
+		excluded[[2]int{b, a}] = true
+	}
+	to, err := Match(len(people), func(i, j int) bool {
+		return i != j && !excluded[[2]int{i, j}]
+	}, r)
+	if err != nil {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: the exclusions leave no valid draw", ErrImpossible)}
+	}
+	draw := make(map[string]string, len(people))
+	for i, j := range to {
+		draw[people[i]] = people[j]
+	}
+	return draw, nil
+}
+
+// matching is a complete assignment of givers to receivers, some of whose givers are fixed.
+type matching struct {
+	adj   [][]int // the allowed receivers of every giver
+	to    []int   // the receiver of every giver, -1 if none
+	from  []int   // the giver of every receiver, -1 if none
+	fixed []bool  // the givers whose receiver was drawn
+}
+
+// augment is one of the inner functions of this package.
+// It looks for an alternating path from giver i to a free receiver through givers which aren't fixed,
+// and flips it so that i gets a receiver. visited marks the receivers already tried.
+func (m *matching) augment(i int, visited []bool) bool {
+	for _, j := range m.adj[i] {
+		if visited[j] {
+			continue
+		}
+		visited[j] = true
+		if k := m.from[j]; k < 0 || (!m.fixed[k] && m.augment(k, visited)) {
+			m.to[i], m.from[j] = j, i
+			return true
+		}
+	}
+	return false
+}
+
+// move is one of the inner functions of this package.
+// It gives receiver j to giver i, and reassigns the giver who had j, if the remaining givers still have a complete assignment.
+// It reports whether it succeeded, on failure the assignment is left as it was.
+func (m *matching) move(i int, j int) bool {
+	old := m.to[i]
+	if old == j {
+		return true
+	}
+	k := m.from[j]
+	if k >= 0 && m.fixed[k] {
+		return false
+	}
+	to := append([]int(nil), m.to...)
+	from := append([]int(nil), m.from...)
+	m.to[i], m.from[j], m.from[old] = j, i, -1
+	if k < 0 {
+		return true
+	}
+	m.to[k] = -1
+	m.fixed[i] = true
+	ok := m.augment(k, make([]bool, len(m.from)))
+	m.fixed[i] = false
+	if !ok {
+		copy(m.to, to)
+		copy(m.from, from)
+	}
+	return ok
+}
@@ -0,0 +1,145 @@
+/*
+ * File: teams.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+// Package assignment splits people into random teams and draws random assignments under constraints,
+// like secret santa draws where spouses can't draw each other.
+// Constraints which can't be satisfied are reported with ErrImpossible instead of looping forever.
+package assignment
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"github.com/anonyindian/random-go"
+)
+
+// ErrImpossible is reported when no assignment satisfies the constraints.
+var ErrImpossible = errors.New("constraints can't be satisfied")
+
+// TeamsOfSize function is used to split people into random teams of size members.
+// When people don't divide evenly, there are as many teams as needed and their sizes differ by at most 1,
+// so no team is left with a single straggler.
+// r (type *rand.Rand) is the generator used, nil uses random.Default().
+// It returns the teams in a slice of type [][]T and any write error encountered.
+// example: assignment.TeamsOfSize([]string{"ann", "bob", "cid", "dan", "eve"}, 2, nil), returns teams like [[eve bob] [ann dan] [cid]].
+func TeamsOfSize[T any](people []T, size int, r *rand.Rand) ([][]T, error) {
+	const fn = "TeamsOfSize"
+	if size < 1 {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: size must be positive", random.ErrInvalid)}
+	}
+	return deal(people, (len(people)+size-1)/size, r), nil
+}
+
+// TeamsOfCount function is used to split people into count random teams, whose sizes differ by at most 1.
+// r (type *rand.Rand) is the generator used, nil uses random.Default().
+// It returns the teams in a slice of type [][]T and any write error encountered.
+// example: assignment.TeamsOfCount([]string{"ann", "bob", "cid", "dan", "eve"}, 2, nil), returns teams like [[cid ann eve] [dan bob]].
+func TeamsOfCount[T any](people []T, count int, r *rand.Rand) ([][]T, error) {
+	const fn = "TeamsOfCount"
+	if count < 1 {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: count must be positive", random.ErrInvalid)}
+	}
+	if count > len(people) {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: more teams than people", ErrImpossible)}
+	}
+	return deal(people, count, r), nil
+}
+
+// BalancedTeams function is used to split people into count random teams of balanced skill.
+// The sizes of the teams differ by at most 1. People are handed out from the most skilled one, each to the team
+// with the smallest skill sum which has room left, and people of equal skill are handed out in random order,
+// so the draw stays random while the sums end up close.
+// r (type *rand.Rand) is the generator used, nil uses random.Default().
+// It returns the teams in a slice of type [][]T and any write error encountered.
+// example: assignment.BalancedTeams(players, 4, func(p Player) float64 { return p.Rating }, nil), returns 4 teams of similar total rating.
+func BalancedTeams[T any](people []T, count int, skill func(T) float64, r *rand.Rand) ([][]T, error) {
+	const fn = "BalancedTeams"
+	if count < 1 {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: count must be positive", random.ErrInvalid)}
+	}
+	if count > len(people) {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: more teams than people", ErrImpossible)}
+	}
+	if r == nil {
+		r = random.Default()
+	}
+	order := append([]T(nil), people...)
+	r.Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+	sort.SliceStable(order, func(i, j int) bool {
+		return skill(order[i]) > skill(order[j])
+	})
+	teams := make([][]T, count)
+	sums := make([]float64, count)
+	// The first len(people) % count teams get one more member.
+	capacity := func(t int) int {
+		c := len(people) / count
+		if t < len(people)%count {
+			c++
+		}
+		return c
+	}
+	for _, p := range order {
+		best, ties := -1, 0
+		for t := range teams {
+			if len(teams[t]) >= capacity(t) {
+				continue
+			}
+			switch {
+			case best < 0 || sums[t] < sums[best]:
+				best, ties = t, 1
+			case sums[t] == sums[best]:
+				// Reservoir choice among the tied teams.
+				ties++
+				if r.Intn(ties) == 0 {
+					best = t
+				}
+			}
+		}
+		teams[best] = append(teams[best], p)
+		sums[best] += skill(p)
+	}
+	r.Shuffle(len(teams), func(i, j int) {
+		teams[i], teams[j] = teams[j], teams[i]
+	})
+	return teams, nil
+}
+
+// deal is one of the inner functions of this package.
+// It shuffles people and deals them round-robin into count teams.
+func deal[T any](people []T, count int, r *rand.Rand) [][]T {
+	if r == nil {
+		r = random.Default()
+	}
+	order := append([]T(nil), people...)
+	r.Shuffle(len(order), func(i, j int) {
+		order[i], order[j] = order[j], order[i]
+	})
+	teams := make([][]T, count)
+	for i, p := range order {
+		teams[i%count] = append(teams[i%count], p)
+	}
+	return teams
+}
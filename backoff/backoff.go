@@ -0,0 +1,201 @@
+/*
+ * File: backoff.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+// Package backoff retries failing operations with exponential backoff and jitter.
+// A Backoff combines a Policy, which computes the delays, with limits on the attempts and the elapsed time.
+// The clock and the generator are injectable, so retry timing can be tested without sleeping.
+package backoff
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+
+	"github.com/anonyindian/random-go"
+)
+
+// Jitter decides how an Exponential policy randomizes its delays.
+type Jitter int
+
+const (
+	NoJitter    Jitter = iota // use the exponential delay as it is
+	FullJitter                // draw the delay from [0, d], see random.FullJitter
+	EqualJitter               // draw the delay from [d/2, d], see random.EqualJitter
+)
+
+// Policy computes the delay before a retry.
+type Policy interface {
+	// Delay returns the delay before retry number attempt, starting at 1, given the previous delay which is 0 before the first retry.
+	Delay(attempt int, prev time.Duration, r *rand.Rand) time.Duration
+}
+
+// Exponential is a Policy whose delays grow by Multiplier after every attempt, starting at Initial.
+// A positive Cap makes it a capped exponential policy.
+type Exponential struct {
+	Initial    time.Duration // the delay before the first retry
+	Multiplier float64       // the growth factor of the delays, 2 if it is 0
+	Cap        time.Duration // the longest delay before jitter, 0 means no cap
+	Jitter     Jitter        // the way the delays are randomized
+}
+
+// Delay returns Initial * Multiplier^(attempt-1), capped at Cap and randomized by Jitter.
+func (e Exponential) Delay(attempt int, prev time.Duration, r *rand.Rand) time.Duration {
+	m := e.Multiplier
+	if m == 0 {
+		m = 2
+	}
+	max := time.Duration(math.MaxInt64)
+	if e.Cap > 0 {
+		max = e.Cap
+	}
+	d := max
+	if f := float64(e.Initial) * math.Pow(m, float64(attempt-1)); f < float64(max) {
+		d = time.Duration(f)
+	}
+	switch e.Jitter {
+	case FullJitter:
+		return random.FullJitter(d, r)
+	case EqualJitter:
+		return random.EqualJitter(d, r)
+	}
+	return d
+}
+
+// Decorrelated is a Policy with decorrelated jitter, every delay is drawn from [Base, 3 * the previous delay] and capped at Cap.
+// It spreads the retries of many clients well while growing about as fast as an exponential policy.
+type Decorrelated struct {
+	Base time.Duration // the shortest delay, and the previous delay of the first retry, time.Millisecond if it is 0
+	Cap  time.Duration // the longest delay, 0 means no cap
+}
+
+// Delay returns a delay with decorrelated jitter, see random.DecorrelatedJitter.
+func (d Decorrelated) Delay(attempt int, prev time.Duration, r *rand.Rand) time.Duration {
+	base := d.Base
+	if base <= 0 {
+		base = time.Millisecond
+	}
+	max := time.Duration(math.MaxInt64)
+	if d.Cap > 0 {
+		max = d.Cap
+	}
+	if prev < base {
+		prev = base
+	}
+	return random.DecorrelatedJitter(base, prev, max, r)
+}
+
+// Backoff retries operations following a Policy.
+// The zero value of every field but Policy is ready to use.
+type Backoff struct {
+	Policy      Policy        // the delays between the attempts
+	MaxAttempts int           // the number of attempts including the first one, 0 means no limit
+	MaxElapsed  time.Duration // the time after which no retry starts, 0 means no limit
+	Clock       Clock         // the clock used to wait, nil uses SystemClock
+	Rand        *rand.Rand    // the generator used for jitter, nil uses random.Default()
+}
+
+// Retry function is used to call op until it succeeds, returns a Permanent error, the limits of b are reached or ctx is done.
+// A retry which would start after MaxElapsed isn't made, so the limit is never overrun by waiting.
+// It returns nil on success, the unwrapped error of a Permanent error, the last error of op when the limits are reached,
+// or ctx.Err() when ctx is done first. It returns ErrInvalid without calling op if b has no Policy.
+// example: b.Retry(ctx, func(ctx context.Context) error { return client.Ping(ctx) }), returns nil once a ping succeeds.
+func (b *Backoff) Retry(ctx context.Context, op func(ctx context.Context) error) error {
+	if b.Policy == nil {
+		return &random.Error{Func: "Backoff.Retry", Err: fmt.Errorf("%w: the backoff has no policy", random.ErrInvalid)}
+	}
+	clock := b.Clock
+	if clock == nil {
+		clock = SystemClock
+	}
+	r := b.Rand
+	if r == nil {
+		r = random.Default()
+	}
+	start := clock.Now()
+	var delay time.Duration
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := op(ctx)
+		if err == nil {
+			return nil
+		}
+		var p *permanentError
+		if errors.As(err, &p) {
+			return p.err
+		}
+		if b.MaxAttempts > 0 && attempt >= b.MaxAttempts {
+			return err
+		}
+		delay = b.Policy.Delay(attempt, delay, r)
+		if b.MaxElapsed > 0 && clock.Now().Sub(start)+delay > b.MaxElapsed {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-clock.After(delay):
+		}
+	}
+}
+
+// Delays function returns the first n delays of b, which shows the retry timing of a policy without running anything.
+// example: (&backoff.Backoff{Policy: backoff.Exponential{Initial: time.Second}}).Delays(4), returns [1s 2s 4s 8s].
+func (b *Backoff) Delays(n int) []time.Duration {
+	r := b.Rand
+	if r == nil {
+		r = random.Default()
+	}
+	delays := make([]time.Duration, n)
+	var delay time.Duration
+	for i := range delays {
+		delay = b.Policy.Delay(i+1, delay, r)
+		delays[i] = delay
+	}
+	return delays
+}
+
+// permanentError is the error returned by Permanent.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string {
+	return e.err.Error()
+}
+
+func (e *permanentError) Unwrap() error {
+	return e.err
+}
+
+// Permanent function wraps err so that Retry stops at once and returns err.
+// example: return backoff.Permanent(ErrNotFound), stops retrying an operation which can't succeed.
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentError{err}
+}
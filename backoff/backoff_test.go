@@ -0,0 +1,159 @@
+/*
+ * File: backoff_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package backoff_test
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/anonyindian/random-go"
+	"github.com/anonyindian/random-go/backoff"
+)
+
+var errFail = errors.New("fail")
+
+// run retries an operation which always fails on a fake clock and returns the waits of the clock.
+func run(t *testing.T, b *backoff.Backoff) []time.Duration {
+	t.Helper()
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := backoff.NewFakeClock(start)
+	b.Clock = clock
+	calls := 0
+	err := b.Retry(context.Background(), func(context.Context) error {
+		calls++
+		return errFail
+	})
+	if err != errFail {
+		t.Fatalf("Retry returned %v, want %v", err, errFail)
+	}
+	waits := clock.Waits()
+	if calls != len(waits)+1 {
+		t.Fatalf("%d calls for %d waits", calls, len(waits))
+	}
+	var total time.Duration
+	for _, w := range waits {
+		total += w
+	}
+	if got := clock.Now().Sub(start); got != total {
+		t.Fatalf("the clock moved by %v, want %v", got, total)
+	}
+	return waits
+}
+
+func TestExponential(t *testing.T) {
+	waits := run(t, &backoff.Backoff{
+		Policy:      backoff.Exponential{Initial: 100 * time.Millisecond, Cap: time.Second},
+		MaxAttempts: 7,
+	})
+	want := []time.Duration{100, 200, 400, 800, 1000, 1000}
+	if len(waits) != len(want) {
+		t.Fatalf("waits %v, want %d of them", waits, len(want))
+	}
+	for i, w := range waits {
+		if w != want[i]*time.Millisecond {
+			t.Fatalf("wait %d is %v, want %v", i, w, want[i]*time.Millisecond)
+		}
+	}
+}
+
+func TestExponentialJitter(t *testing.T) {
+	for _, jitter := range []backoff.Jitter{backoff.FullJitter, backoff.EqualJitter} {
+		waits := run(t, &backoff.Backoff{
+			Policy:      backoff.Exponential{Initial: 100 * time.Millisecond, Multiplier: 3, Cap: 2 * time.Second, Jitter: jitter},
+			MaxAttempts: 8,
+			Rand:        rand.New(rand.NewSource(1)),
+		})
+		d := 100 * time.Millisecond
+		for i, w := range waits {
+			lo := time.Duration(0)
+			if jitter == backoff.EqualJitter {
+				lo = d / 2
+			}
+			if w < lo || w > d {
+				t.Fatalf("jitter %d: wait %d is %v, outside [%v, %v]", jitter, i, w, lo, d)
+			}
+			if d *= 3; d > 2*time.Second {
+				d = 2 * time.Second
+			}
+		}
+	}
+}
+
+func TestDecorrelated(t *testing.T) {
+	const base, max = 50 * time.Millisecond, time.Second
+	waits := run(t, &backoff.Backoff{
+		Policy:      backoff.Decorrelated{Base: base, Cap: max},
+		MaxAttempts: 30,
+		Rand:        rand.New(rand.NewSource(1)),
+	})
+	prev := base
+	for i, w := range waits {
+		upper := 3 * prev
+		if upper > max {
+			upper = max
+		}
+		if w < base || w > upper {
+			t.Fatalf("wait %d is %v, outside [%v, %v]", i, w, base, upper)
+		}
+		prev = w
+	}
+	if waits[len(waits)-1] == base {
+		t.Fatal("the delays never grew")
+	}
+}
+
+func TestDecorrelatedZeroBase(t *testing.T) {
+	delays := (&backoff.Backoff{Policy: backoff.Decorrelated{}, Rand: rand.New(rand.NewSource(1))}).Delays(10)
+	for i, d := range delays {
+		if d <= 0 {
+			t.Fatalf("delay %d is %v", i, d)
+		}
+	}
+}
+
+func TestMaxElapsed(t *testing.T) {
+	waits := run(t, &backoff.Backoff{
+		Policy:     backoff.Exponential{Initial: time.Second},
+		MaxElapsed: 10 * time.Second,
+	})
+	// 1s + 2s + 4s leaves no room for the 8s wait.
+	if len(waits) != 3 {
+		t.Fatalf("waits %v, want 3 of them", waits)
+	}
+}
+
+func TestRetryNoPolicy(t *testing.T) {
+	called := false
+	err := (&backoff.Backoff{}).Retry(context.Background(), func(context.Context) error {
+		called = true
+		return nil
+	})
+	if !errors.Is(err, random.ErrInvalid) {
+		t.Fatalf("Retry returned %v, want ErrInvalid", err)
+	}
+	if called {
+		t.Fatal("Retry called op without a policy")
+	}
+}
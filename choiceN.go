@@ -38,16 +38,18 @@ func ChoiceN(n int, a ...interface{}) ([]interface{}, error) {
 	if n > len(a) {
 		return nil, &Error{fn, ErrExceed}
 	}
-	var cs []interface{}
-	for i := 1; i <= n; i++ {
-		if len(a) > 1 {
-			if reflect.TypeOf(a[i-1]).Kind() == reflect.Slice {
+	if len(a) > 1 {
+		for _, x := range a {
+			if reflect.TypeOf(x).Kind() == reflect.Slice {
 				return nil, &Error{fn, fmt.Errorf("%w: slice", ErrUnsupported)}
 			}
-			if reflect.TypeOf(a[i-1]).Kind() == reflect.Array {
+			if reflect.TypeOf(x).Kind() == reflect.Array {
 				return nil, &Error{fn, fmt.Errorf("%w: array", ErrUnsupported)}
 			}
 		}
+	}
+	var cs []interface{}
+	for i := 1; i <= n; i++ {
 		c := Choice(a...)
 		cs = append(cs, c)
 		a = removeChoiceFromSlice(c, a)
@@ -267,12 +269,13 @@ func ChoiceFloat64N(n int, a []float64) (interface{}, error) {
 
 // removeChoiceFromSlice is one of the inner functions of this package.
 // This function is used to remove a choice (type interface{}) from a slice of interfaces (type []interface{})
+// Only the first occurrence is removed, so equal values can each be chosen once.
 // It returns a slice of type []interface{}.
 func removeChoiceFromSlice(c interface{}, a []interface{}) []interface{} {
 	for x, i := range a {
 		if i == c {
 			a[x] = a[len(a)-1]
-			a = a[:len(a)-1]
+			return a[:len(a)-1]
 		}
 	}
 	return a
@@ -0,0 +1,302 @@
+/*
+ * File: dataset.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+// Package dataset generates synthetic tables for tests and QA and streams them as CSV or JSON Lines.
+// A Spec declares tables of typed columns: sequential IDs, weighted categories, uniform and normal numbers,
+// dates, strings and foreign keys into other tables, and columns may depend on the category of another column.
+// Rows are generated and written one at a time, so memory use doesn't grow with the number of rows.
+// Specs are written in Go with struct tags, in JSON or in YAML.
+package dataset
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/anonyindian/random-go"
+	"gopkg.in/yaml.v3"
+)
+
+// Column kinds.
+const (
+	KindID       = "id"       // sequential integers from Start, 1 by default
+	KindInt      = "int"      // integers drawn uniformly from [Min, Max]
+	KindFloat    = "float"    // floats drawn uniformly from [Min, Max)
+	KindNormal   = "normal"   // normally distributed floats of Mean and StdDev, truncated to [Min, Max] if Max > Min
+	KindCategory = "category" // one of Values, drawn following Weights
+	KindBool     = "bool"     // true or false
+	KindDate     = "date"     // days between From and To, formatted like 2006-01-02
+	KindDateTime = "datetime" // times between From and To, formatted in RFC 3339
+	KindString   = "string"   // alphanumeric strings of Length runes, or strings matching Pattern
+	KindUUID     = "uuid"     // random UUIDs of version 4
+	KindRef      = "ref"      // foreign keys drawn uniformly from the id column of the table Ref
+)
+
+// The defaults of the ranges of columns which leave them unset.
+const (
+	DefaultSpan     = 1000                 // the width of the range of int and float columns
+	DefaultFrom     = "2024-01-01"         // the start of the range of date and datetime columns
+	DefaultDuration = 365 * 24 * time.Hour // the length of the range of date and datetime columns
+)
+
+// Column describes a column of a Table. Only the fields of its Kind are used.
+// An int or float column whose Max is 0 and not greater than Min draws values from Min up to Min + DefaultSpan.
+// A date or datetime column without From starts at DefaultFrom, or DefaultDuration before To,
+// and one without To ends DefaultDuration after From.
+type Column struct {
+	Name     string            `json:"name"`
+	Kind     string            `json:"type"`
+	Start    int64             `json:"start,omitempty"`
+	Min      float64           `json:"min,omitempty"`
+	Max      float64           `json:"max,omitempty"`
+	Mean     float64           `json:"mean,omitempty"`
+	StdDev   float64           `json:"stddev,omitempty"`
+	Decimals *int              `json:"decimals,omitempty"` // the number of decimals floats are rounded to, nil keeps them all
+	Values   []string          `json:"values,omitempty"`
+	Weights  []float64         `json:"weights,omitempty"` // the weights of Values, nil draws them uniformly
+	From     string            `json:"from,omitempty"`
+	To       string            `json:"to,omitempty"`
+	Length   int               `json:"length,omitempty"`
+	Pattern  string            `json:"pattern,omitempty"`
+	Ref      string            `json:"ref,omitempty"`
+	Nulls    float64           `json:"nulls,omitempty"` // the probability of an empty value
+	By       string            `json:"by,omitempty"`    // the category column whose value picks one of Cases
+	Cases    map[string]Column `json:"cases,omitempty"` // the column to use instead, for each value of By
+}
+
+// Table describes a table of Rows rows.
+type Table struct {
+	Name    string   `json:"name"`
+	Rows    int64    `json:"rows"`
+	Columns []Column `json:"columns"`
+}
+
+// Spec is a set of tables which may refer to each other.
+type Spec struct {
+	Tables []Table `json:"tables"`
+}
+
+// ParseSpec function is used to decode a JSON spec.
+// It returns the spec of type *Spec and any write error encountered.
+// example:
+//
+//	dataset.ParseSpec([]byte(`{"tables": [{"name": "orders", "rows": 1000000, "columns": [
+//		{"name": "id", "type": "id"},
+//		{"name": "status", "type": "category", "values": ["new", "paid"], "weights": [1, 9]},
+//		{"name": "amount", "type": "normal", "mean": 50, "stddev": 20, "min": 0, "max": 500, "decimals": 2}]}]}`))
+func ParseSpec(data []byte) (*Spec, error) {
+	s := new(Spec)
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, &random.Error{Func: "ParseSpec", Err: fmt.Errorf("%w: %v", random.ErrInvalid, err)}
+	}
+	return s, nil
+}
+
+// ParseSpecYAML function is used to decode a YAML spec, which has the same fields as a JSON spec.
+// It returns the spec of type *Spec and any write error encountered.
+// example:
+//
+//	dataset.ParseSpecYAML([]byte(`
+//	tables:
+//	  - name: orders
+//	    rows: 1000000
+//	    columns:
+//	      - {name: id, type: id}
+//	      - {name: status, type: category, values: [new, paid], weights: [1, 9]}
+//	      - {name: placed, type: date, from: 2024-01-01, to: 2025-01-01}`))
+func ParseSpecYAML(data []byte) (*Spec, error) {
+	const fn = "ParseSpecYAML"
+	var v interface{}
+	if err := yaml.Unmarshal(data, &v); err != nil {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: %v", random.ErrInvalid, err)}
+	}
+	// The YAML is decoded through JSON so that both formats follow the same rules.
+	b, err := json.Marshal(jsonValue(v))
+	if err != nil {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: %v", random.ErrInvalid, err)}
+	}
+	s := new(Spec)
+	if err := json.Unmarshal(b, s); err != nil {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: %v", random.ErrInvalid, err)}
+	}
+	return s, nil
+}
+
+// jsonValue is one of the inner functions of this package.
+// It converts a decoded YAML value to one encoding/json can marshal: mappings with keys which aren't strings,
+// like the numbers or booleans of the Cases of a column, get string keys and unquoted dates keep their YAML form.
+func jsonValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, x := range v {
+			v[k] = jsonValue(x)
+		}
+		return v
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, x := range v {
+			m[fmt.Sprint(k)] = jsonValue(x)
+		}
+		return m
+	case []interface{}:
+		for i, x := range v {
+			v[i] = jsonValue(x)
+		}
+		return v
+	case time.Time:
+		if v.Equal(v.Truncate(24 * time.Hour)) {
+			return v.Format("2006-01-02")
+		}
+		return v.Format(time.RFC3339Nano)
+	}
+	return v
+}
+
+// FromStruct function is used to get a Table of rows rows from the fields of the struct v.
+// Every exported field is a column named after the field, its kind follows the type of the field and the `dataset` tag:
+//
+//	ID     int64     `dataset:"type=id"`
+//	User   int64     `dataset:"name=user_id,type=ref,ref=users"`
+//	Status string    `dataset:"type=category,values=new|paid|sent,weights=1|6|3"`
+//	Amount float64   `dataset:"type=normal,mean=50,stddev=20,min=0,decimals=2"`
+//	Placed time.Time `dataset:"from=2024-01-01,to=2025-01-01"`
+//	Note   string    `dataset:"-"`
+//
+// Without a type, integers are int columns, floats are float columns, bools are bool columns,
+// time.Time fields are datetime columns and strings are string columns, their ranges default like those of Column
+// with the default range of an integer capped at the largest value of its type.
+// It returns the table of type Table and any write error encountered.
+// example: dataset.FromStruct("orders", 1000000, Order{}), returns a table of a million orders.
+func FromStruct(name string, rows int64, v interface{}) (Table, error) {
+	const fn = "FromStruct"
+	t := Table{Name: name, Rows: rows}
+	typ := reflect.TypeOf(v)
+	if typ != nil && typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return t, &random.Error{Func: fn, Err: fmt.Errorf("%w: need a struct", random.ErrInvalid)}
+	}
+	for i := 0; i < typ.NumField(); i++ {
+		f := typ.Field(i)
+		tag := f.Tag.Get("dataset")
+		if f.PkgPath != "" || tag == "-" {
+			continue
+		}
+		c := Column{Name: f.Name}
+		switch {
+		case f.Type == reflect.TypeOf(time.Time{}):
+			c.Kind = KindDateTime
+		case f.Type.Kind() == reflect.Bool:
+			c.Kind = KindBool
+		case f.Type.Kind() >= reflect.Int && f.Type.Kind() <= reflect.Uint64:
+			c.Kind = KindInt
+		case f.Type.Kind() == reflect.Float32 || f.Type.Kind() == reflect.Float64:
+			c.Kind = KindFloat
+		case f.Type.Kind() == reflect.String:
+			c.Kind = KindString
+		}
+		if err := parseTag(tag, &c); err != nil {
+			return t, &random.Error{Func: fn, Err: fmt.Errorf("field %s: %w", f.Name, err)}
+		}
+		if c.Kind == "" {
+			return t, &random.Error{Func: fn, Err: fmt.Errorf("field %s: %w: type %s", f.Name, random.ErrUnsupported, f.Type)}
+		}
+		// The default range of an int column is capped at the largest value of the field, so an uint8 gets [0, 255].
+		if k := f.Type.Kind(); c.Kind == KindInt && k >= reflect.Int && k <= reflect.Uint64 && c.Max == 0 && c.Min >= 0 {
+			max := math.Ldexp(1, f.Type.Bits()) - 1
+			if k <= reflect.Int64 {
+				max = math.Ldexp(1, f.Type.Bits()-1) - 1
+			}
+			c.Max = math.Min(c.Min+DefaultSpan, max)
+		}
+		t.Columns = append(t.Columns, c)
+	}
+	return t, nil
+}
+
+// parseTag is one of the inner functions of this package.
+// It sets the fields of c from a `dataset:"..."` struct tag.
+func parseTag(tag string, c *Column) error {
+	if tag == "" {
+		return nil
+	}
+	for _, kv := range strings.Split(tag, ",") {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return fmt.Errorf("%w: tag option %q has no value", random.ErrInvalid, kv)
+		}
+		var err error
+		switch k {
+		case "name":
+			c.Name = v
+		case "type":
+			c.Kind = v
+		case "start":
+			c.Start, err = strconv.ParseInt(v, 10, 64)
+		case "min":
+			c.Min, err = strconv.ParseFloat(v, 64)
+		case "max":
+			c.Max, err = strconv.ParseFloat(v, 64)
+		case "mean":
+			c.Mean, err = strconv.ParseFloat(v, 64)
+		case "stddev":
+			c.StdDev, err = strconv.ParseFloat(v, 64)
+		case "decimals":
+			var d int
+			d, err = strconv.Atoi(v)
+			c.Decimals = &d
+		case "values":
+			c.Values = strings.Split(v, "|")
+		case "weights":
+			for _, w := range strings.Split(v, "|") {
+				var x float64
+				if x, err = strconv.ParseFloat(w, 64); err != nil {
+					break
+				}
+				c.Weights = append(c.Weights, x)
+			}
+		case "from":
+			c.From = v
+		case "to":
+			c.To = v
+		case "length":
+			c.Length, err = strconv.Atoi(v)
+		case "pattern":
+			c.Pattern = v
+		case "ref":
+			c.Ref = v
+		case "nulls":
+			c.Nulls, err = strconv.ParseFloat(v, 64)
+		default:
+			return fmt.Errorf("%w: unknown tag option %q", random.ErrInvalid, k)
+		}
+		if err != nil {
+			return fmt.Errorf("%w: tag option %s: %v", random.ErrInvalid, k, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,215 @@
+/*
+ * File: dataset_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package dataset_test
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anonyindian/random-go"
+	"github.com/anonyindian/random-go/dataset"
+)
+
+type order struct {
+	ID     int64 `dataset:"type=id"`
+	Qty    int
+	Small  uint8
+	Price  float64
+	Placed time.Time
+	Paid   bool
+	Note   string
+}
+
+func TestFromStructWriteTable(t *testing.T) {
+	table, err := dataset.FromStruct("orders", 500, order{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := dataset.WriteTable(&b, table, dataset.CSV, random.NewRand(1)); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(&b).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 501 {
+		t.Fatalf("got %d records, want a header and 500 rows", len(records))
+	}
+	from, _ := time.Parse("2006-01-02", dataset.DefaultFrom)
+	to := from.Add(dataset.DefaultDuration)
+	for i, rec := range records[1:] {
+		if rec[0] != strconv.Itoa(i+1) {
+			t.Errorf("row %d: ID = %s", i, rec[0])
+		}
+		if n, err := strconv.Atoi(rec[1]); err != nil || n < 0 || n > dataset.DefaultSpan {
+			t.Errorf("row %d: integer %q out of [0, %d]", i, rec[1], dataset.DefaultSpan)
+		}
+		if n, err := strconv.Atoi(rec[2]); err != nil || n < 0 || n > math.MaxUint8 {
+			t.Errorf("row %d: uint8 %q out of [0, %d]", i, rec[2], math.MaxUint8)
+		}
+		if f, err := strconv.ParseFloat(rec[3], 64); err != nil || f < 0 || f >= dataset.DefaultSpan {
+			t.Errorf("row %d: float %q out of [0, %d)", i, rec[3], dataset.DefaultSpan)
+		}
+		if p, err := time.Parse(time.RFC3339, rec[4]); err != nil || p.Before(from) || !p.Before(to) {
+			t.Errorf("row %d: time %q out of [%s, %s)", i, rec[4], from, to)
+		}
+		if rec[5] != "true" && rec[5] != "false" {
+			t.Errorf("row %d: bool %q", i, rec[5])
+		}
+		if len(rec[6]) != 8 {
+			t.Errorf("row %d: string %q", i, rec[6])
+		}
+	}
+}
+
+func TestWriteReproducible(t *testing.T) {
+	table, err := dataset.FromStruct("orders", 100, &order{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var a, b bytes.Buffer
+	dataset.WriteTable(&a, table, dataset.JSONL, random.NewRand(7))
+	dataset.WriteTable(&b, table, dataset.JSONL, random.NewRand(7))
+	if a.String() != b.String() {
+		t.Error("the same seed wrote different data")
+	}
+}
+
+func TestWriteCasesWithNulls(t *testing.T) {
+	table := dataset.Table{Name: "t", Rows: 200, Columns: []dataset.Column{
+		{Name: "kind", Kind: dataset.KindCategory, Values: []string{"a", "b"}},
+		{Name: "v", By: "kind", Nulls: 0.5, Cases: map[string]dataset.Column{
+			"a": {Kind: dataset.KindInt, Min: 1, Max: 9},
+		}},
+	}}
+	var b bytes.Buffer
+	if err := dataset.WriteTable(&b, table, dataset.CSV, random.NewRand(1)); err != nil {
+		t.Fatal(err)
+	}
+	recs, err := csv.NewReader(&b).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, rec := range recs[1:] {
+		if rec[0] == "b" && rec[1] != "" {
+			t.Errorf("row %d: %q for a value without a case", i, rec[1])
+		}
+	}
+}
+
+func TestWriteSingleDay(t *testing.T) {
+	table := dataset.Table{Name: "t", Rows: 20, Columns: []dataset.Column{
+		{Name: "d", Kind: dataset.KindDate, From: "2024-05-01T08:00:00Z", To: "2024-05-01T17:00:00Z"},
+	}}
+	var b bytes.Buffer
+	if err := dataset.WriteTable(&b, table, dataset.CSV, random.NewRand(1)); err != nil {
+		t.Fatal(err)
+	}
+	recs, err := csv.NewReader(&b).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, rec := range recs[1:] {
+		if rec[0] != "2024-05-01" {
+			t.Errorf("row %d: date %q, want 2024-05-01", i, rec[0])
+		}
+	}
+}
+
+func TestParseSpecYAML(t *testing.T) {
+	spec, err := dataset.ParseSpecYAML([]byte(`
+tables:
+  - name: users
+    rows: 10
+    columns:
+      - {name: id, type: id}
+  - name: orders
+    rows: 200
+    columns:
+      - name: id
+        type: id
+      - name: user
+        type: ref
+        ref: users
+      - name: status
+        type: category
+        values: [new, paid]
+        weights: [1, 3]
+      - name: placed
+        type: date
+        from: 2024-01-01
+        to: 2024-02-01
+      - name: amount
+        type: float
+        by: status
+        cases:
+          new: {type: float, min: 0, max: 10}
+          paid: {type: float, min: 100, max: 200}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var b bytes.Buffer
+	if err := spec.Write(&b, "orders", dataset.JSONL, random.NewRand(1)); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != 200 {
+		t.Fatalf("got %d rows, want 200", len(lines))
+	}
+	for _, l := range lines {
+		var row struct {
+			User   int64
+			Status string
+			Placed string
+			Amount float64
+		}
+		if err := json.Unmarshal([]byte(l), &row); err != nil {
+			t.Fatal(err)
+		}
+		if row.User < 1 || row.User > 10 {
+			t.Errorf("user %d is not an id of users", row.User)
+		}
+		if row.Placed < "2024-01-01" || row.Placed > "2024-02-01" {
+			t.Errorf("date %s out of range", row.Placed)
+		}
+		if (row.Status == "new") != (row.Amount < 10) {
+			t.Errorf("amount %g doesn't follow status %s", row.Amount, row.Status)
+		}
+	}
+}
+
+func TestParseSpecYAMLInvalid(t *testing.T) {
+	if _, err := dataset.ParseSpecYAML([]byte("tables: [")); err == nil {
+		t.Error("no error for broken YAML")
+	}
+	if _, err := dataset.ParseSpecYAML([]byte("tables: {rows: many}")); err == nil {
+		t.Error("no error for a spec of the wrong shape")
+	}
+}
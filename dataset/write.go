@@ -0,0 +1,445 @@
+/*
+ * File: write.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package dataset
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"strconv"
+	"time"
+
+	"github.com/anonyindian/random-go"
+)
+
+// Format is the output format of Write.
+type Format int
+
+const (
+	CSV   Format = iota // comma-separated values with a header row, empty values for nulls
+	JSONL               // JSON Lines, one object per row with the columns in order
+)
+
+// cell generates the value of a column for row i, given the values of the previous columns of the row.
+// Values are nil, bools, strings or json.Number.
+type cell func(i int64, row []interface{}) interface{}
+
+// Write function is used to generate the table named table and stream its rows to w in the given format.
+// Rows are generated one at a time, so any number of rows can be written with constant memory.
+// r (type *rand.Rand) is the generator used, a seeded one makes the data reproducible and nil uses random.Default().
+// It returns any write error encountered.
+// example: spec.Write(file, "orders", dataset.CSV, random.NewRand(1)), writes the orders table as CSV.
+func (s *Spec) Write(w io.Writer, table string, format Format, r *rand.Rand) error {
+	const fn = "Write"
+	if r == nil {
+		r = random.Default()
+	}
+	var t *Table
+	for i := range s.Tables {
+		if s.Tables[i].Name == table {
+			t = &s.Tables[i]
+		}
+	}
+	if t == nil {
+		return &random.Error{Func: fn, Err: fmt.Errorf("%w: unknown table %q", random.ErrInvalid, table)}
+	}
+	cells := make([]cell, len(t.Columns))
+	names := make([]string, len(t.Columns))
+	for j, c := range t.Columns {
+		var err error
+		if cells[j], err = s.compile(c, t.Columns[:j], r); err != nil {
+			return &random.Error{Func: fn, Err: fmt.Errorf("table %s, column %s: %w", t.Name, c.Name, err)}
+		}
+		names[j] = c.Name
+	}
+	bw := bufio.NewWriter(w)
+	row := make([]interface{}, len(cells))
+	var err error
+	switch format {
+	case CSV:
+		err = writeCSV(bw, t.Rows, names, cells, row)
+	case JSONL:
+		err = writeJSONL(bw, t.Rows, names, cells, row)
+	default:
+		return &random.Error{Func: fn, Err: fmt.Errorf("%w: format %d", random.ErrUnsupported, format)}
+	}
+	if err == nil {
+		err = bw.Flush()
+	}
+	if err != nil {
+		return &random.Error{Func: fn, Err: err}
+	}
+	return nil
+}
+
+// WriteTable function is used to generate t and stream its rows to w, like Spec.Write for a spec of one table.
+// t can't have foreign keys, as there is no other table.
+// It returns any write error encountered.
+// example: dataset.WriteTable(os.Stdout, table, dataset.JSONL, nil), prints the table as JSON Lines.
+func WriteTable(w io.Writer, t Table, format Format, r *rand.Rand) error {
+	return (&Spec{Tables: []Table{t}}).Write(w, t.Name, format, r)
+}
+
+// writeCSV is one of the inner functions of this package.
+// It writes a header and n rows of CSV to w.
+func writeCSV(w io.Writer, n int64, names []string, cells []cell, row []interface{}) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(names); err != nil {
+		return err
+	}
+	record := make([]string, len(cells))
+	for i := int64(0); i < n; i++ {
+		for j, c := range cells {
+			row[j] = c(i, row)
+			record[j] = text(row[j])
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeJSONL is one of the inner functions of this package.
+// It writes n rows of JSON Lines to w.
+func writeJSONL(w *bufio.Writer, n int64, names []string, cells []cell, row []interface{}) error {
+	keys := make([][]byte, len(names))
+	for j, name := range names {
+		keys[j], _ = json.Marshal(name)
+	}
+	for i := int64(0); i < n; i++ {
+		w.WriteByte('{')
+		for j, c := range cells {
+			row[j] = c(i, row)
+			if j > 0 {
+				w.WriteByte(',')
+			}
+			w.Write(keys[j])
+			w.WriteByte(':')
+			switch v := row[j].(type) {
+			case json.Number:
+				w.WriteString(string(v))
+			case nil:
+				w.WriteString("null")
+			default:
+				b, err := json.Marshal(v)
+				if err != nil {
+					return err
+				}
+				w.Write(b)
+			}
+		}
+		if _, err := w.WriteString("}\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// text is one of the inner functions of this package.
+// It returns the CSV text of a value.
+func text(v interface{}) string {
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return string(v)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	return ""
+}
+
+// compile is one of the inner functions of this package.
+// It returns the cell of column c, prev are the columns before c in its table.
+func (s *Spec) compile(c Column, prev []Column, r *rand.Rand) (cell, error) {
+	var base cell
+	if c.Kind != "" || c.By == "" {
+		var err error
+		if base, err = s.compileKind(c, r); err != nil {
+			return nil, err
+		}
+	}
+	if c.Nulls < 0 || c.Nulls > 1 {
+		return nil, fmt.Errorf("%w: nulls must be in [0, 1]", random.ErrInvalid)
+	}
+	// A column of cases only, with By and no Kind, has no base to return nulls around.
+	if c.Nulls > 0 && base != nil {
+		inner := base
+		base = func(i int64, row []interface{}) interface{} {
+			if r.Float64() < c.Nulls {
+				return nil
+			}
+			return inner(i, row)
+		}
+	}
+	if c.By == "" {
+		return base, nil
+	}
+	by := -1
+	for j := range prev {
+		if prev[j].Name == c.By {
+			by = j
+		}
+	}
+	if by < 0 {
+		return nil, fmt.Errorf("%w: by column %q must come before the column", random.ErrInvalid, c.By)
+	}
+	cases := make(map[string]cell, len(c.Cases))
+	for value, sub := range c.Cases {
+		sub.Name = c.Name
+		var err error
+		if cases[value], err = s.compile(sub, prev, r); err != nil {
+			return nil, fmt.Errorf("case %q: %w", value, err)
+		}
+	}
+	return func(i int64, row []interface{}) interface{} {
+		if f, ok := cases[text(row[by])]; ok {
+			return f(i, row)
+		}
+		if base == nil {
+			return nil
+		}
+		return base(i, row)
+	}, nil
+}
+
+// compileKind is one of the inner functions of this package.
+// It returns the cell of column c following its kind.
+func (s *Spec) compileKind(c Column, r *rand.Rand) (cell, error) {
+	switch c.Kind {
+	case KindID:
+		start := c.Start
+		if start == 0 {
+			start = 1
+		}
+		return func(i int64, row []interface{}) interface{} {
+			return json.Number(strconv.FormatInt(start+i, 10))
+		}, nil
+	case KindInt:
+		c.Min, c.Max = numberRange(c)
+		d, err := random.NewUniformInteger(int(c.Min), int(c.Max))
+		if err != nil {
+			return nil, err
+		}
+		d.Rand = r
+		return func(i int64, row []interface{}) interface{} {
+			return json.Number(strconv.Itoa(d.Sample()))
+		}, nil
+	case KindFloat, KindNormal:
+		var d random.Distribution[float64]
+		if c.Kind == KindFloat {
+			c.Min, c.Max = numberRange(c)
+			u, err := random.NewUniformFloat64(c.Min, c.Max)
+			if err != nil {
+				return nil, err
+			}
+			u.Rand = r
+			d = u
+		} else {
+			n, err := random.NewNormal(c.Mean, c.StdDev)
+			if err != nil {
+				return nil, err
+			}
+			n.Rand = r
+			d = n
+			if c.Max > c.Min {
+				if d, err = random.Truncate[float64](n, c.Min, c.Max); err != nil {
+					return nil, err
+				}
+			}
+		}
+		prec := -1
+		if c.Decimals != nil {
+			prec = *c.Decimals
+		}
+		return func(i int64, row []interface{}) interface{} {
+			return json.Number(strconv.FormatFloat(d.Sample(), 'f', prec, 64))
+		}, nil
+	case KindCategory:
+		if len(c.Values) == 0 {
+			return nil, random.ErrEmpty
+		}
+		if c.Weights == nil {
+			return func(i int64, row []interface{}) interface{} {
+				return c.Values[r.Intn(len(c.Values))]
+			}, nil
+		}
+		components := make([]random.Distribution[string], len(c.Values))
+		for j := range c.Values {
+			v := c.Values[j]
+			components[j] = random.DistributionFunc[string](func() string { return v })
+		}
+		m, err := random.NewMixture(components, c.Weights)
+		if err != nil {
+			return nil, err
+		}
+		m.Rand = r
+		return func(i int64, row []interface{}) interface{} {
+			return m.Sample()
+		}, nil
+	case KindBool:
+		return func(i int64, row []interface{}) interface{} {
+			return r.Intn(2) == 1
+		}, nil
+	case KindDate, KindDateTime:
+		from, to, err := timeRange(c)
+		if err != nil {
+			return nil, err
+		}
+		if !to.After(from) {
+			return nil, random.ErrEndNumSmaller
+		}
+		if c.Kind == KindDate {
+			// random.Date needs two distinct days, a range within one day always gives that day.
+			if day := from.Format("2006-01-02"); day == to.In(from.Location()).Format("2006-01-02") {
+				return func(i int64, row []interface{}) interface{} {
+					return day
+				}, nil
+			}
+			return func(i int64, row []interface{}) interface{} {
+				// The range holds two days at least, so random.Date can't fail.
+				t, _ := random.Date(from, to, r)
+				return t.Format("2006-01-02")
+			}, nil
+		}
+		return func(i int64, row []interface{}) interface{} {
+			// to is after from, so random.Time can't fail.
+			t, _ := random.Time(from, to, r)
+			return t.Truncate(time.Second).Format(time.RFC3339)
+		}, nil
+	case KindString:
+		if c.Pattern != "" {
+			g, err := random.NewRegexString(c.Pattern)
+			if err != nil {
+				return nil, err
+			}
+			g.Rand = r
+			return func(i int64, row []interface{}) interface{} {
+				return g.Sample()
+			}, nil
+		}
+		length := c.Length
+		if length == 0 {
+			length = 8
+		}
+		g, err := random.NewUniformString(length, length, random.AlphabetAlnum)
+		if err != nil {
+			return nil, err
+		}
+		g.Rand = r
+		return func(i int64, row []interface{}) interface{} {
+			return g.Sample()
+		}, nil
+	case KindUUID:
+		ids := &random.IDGenerator{Rand: r}
+		return func(i int64, row []interface{}) interface{} {
+			return ids.UUIDv4().String()
+		}, nil
+	case KindRef:
+		lo, hi, err := s.idRange(c.Ref)
+		if err != nil {
+			return nil, err
+		}
+		return func(i int64, row []interface{}) interface{} {
+			return json.Number(strconv.FormatInt(lo+r.Int63n(hi-lo+1), 10))
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: column type %q", random.ErrUnsupported, c.Kind)
+}
+
+// idRange is one of the inner functions of this package.
+// It returns the first and the last value of the id column of the table named table.
+func (s *Spec) idRange(table string) (int64, int64, error) {
+	for _, t := range s.Tables {
+		if t.Name != table {
+			continue
+		}
+		for _, c := range t.Columns {
+			if c.Kind == KindID {
+				start := c.Start
+				if start == 0 {
+					start = 1
+				}
+				if t.Rows < 1 {
+					return 0, 0, fmt.Errorf("%w: referenced table %q has no rows", random.ErrInvalid, table)
+				}
+				return start, start + t.Rows - 1, nil
+			}
+		}
+		return 0, 0, fmt.Errorf("%w: referenced table %q has no id column", random.ErrInvalid, table)
+	}
+	return 0, 0, fmt.Errorf("%w: unknown referenced table %q", random.ErrInvalid, table)
+}
+
+// numberRange is one of the inner functions of this package.
+// It returns the range [Min, Max] of the int or float column c, with the default of an unset Max.
+func numberRange(c Column) (float64, float64) {
+	if c.Max == 0 && c.Min >= 0 {
+		return c.Min, c.Min + DefaultSpan
+	}
+	return c.Min, c.Max
+}
+
+// timeRange is one of the inner functions of this package.
+// It returns the bounds From and To of the date or datetime column c, with the defaults of unset bounds.
+func timeRange(c Column) (time.Time, time.Time, error) {
+	var from, to time.Time
+	var err error
+	if c.From != "" {
+		if from, err = parseTime(c.From); err != nil {
+			return from, to, err
+		}
+	}
+	if c.To != "" {
+		if to, err = parseTime(c.To); err != nil {
+			return from, to, err
+		}
+	}
+	switch {
+	case c.From == "" && c.To != "":
+		from = to.Add(-DefaultDuration)
+	case c.From == "":
+		from, _ = parseTime(DefaultFrom)
+		fallthrough
+	case c.To == "":
+		to = from.Add(DefaultDuration)
+	}
+	return from, to, nil
+}
+
+// parseTime is one of the inner functions of this package.
+// It parses a time in RFC 3339 or a date like 2006-01-02.
+func parseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: time %q must be in RFC 3339 or like 2006-01-02", random.ErrInvalid, s)
+	}
+	return t, nil
+}
@@ -0,0 +1,188 @@
+/*
+ * File: design.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// maximinPower is the exponent p of the Morris-Mitchell criterion minimised by LatinHypercubeMaximin.
+// Higher values weigh the closest pair of points more.
+const maximinPower = 15
+
+// LatinHypercube function is used to get a Latin hypercube sample of n points.
+// Dimension j is split into n equal strata of the range [startNums[j], endNums[j]) and every stratum
+// holds exactly one point, so each parameter is covered evenly with far fewer points than a full grid.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the points in a slice of type [][]float64 and any write error encountered.
+// example: random.LatinHypercube(10, []float64{0, 100}, []float64{1, 200}, nil), returns 10 points with one point in each tenth of [0, 1) and of [100, 200).
+func LatinHypercube(n int, startNums []float64, endNums []float64, r *rand.Rand) ([][]float64, error) {
+	const fn = "LatinHypercube"
+	if err := checkRanges(startNums, endNums); err != nil {
+		return nil, &Error{fn, err}
+	}
+	if n < 1 {
+		return nil, &Error{fn, fmt.Errorf("%w: n must be positive", ErrInvalid)}
+	}
+	r = rng(r)
+	return lhsPoints(latinStrata(n, len(startNums), r), startNums, endNums, r), nil
+}
+
+// LatinHypercubeMaximin function is used to get a Latin hypercube sample of n points which are spread apart.
+// It works like LatinHypercube, then swaps strata between random pairs of points for the given number of iterations,
+// keeping a swap only if it lowers the Morris-Mitchell criterion, which maximises the distance between the closest points.
+// Each iteration takes O(n * dim) time.
+// It returns the points in a slice of type [][]float64 and any write error encountered.
+// example: random.LatinHypercubeMaximin(20, []float64{0, 0}, []float64{1, 1}, 1000, nil), returns 20 well spread points in the unit square.
+func LatinHypercubeMaximin(n int, startNums []float64, endNums []float64, iterations int, r *rand.Rand) ([][]float64, error) {
+	const fn = "LatinHypercubeMaximin"
+	if err := checkRanges(startNums, endNums); err != nil {
+		return nil, &Error{fn, err}
+	}
+	if n < 1 {
+		return nil, &Error{fn, fmt.Errorf("%w: n must be positive", ErrInvalid)}
+	}
+	r = rng(r)
+	s := latinStrata(n, len(startNums), r)
+	// The criterion is computed on the strata indexes, points differ by at least 1 in every dimension
+	// so the terms of the sum never exceed 1.
+	term := func(a, b []int) float64 {
+		d := 0
+		for j := range a {
+			d += (a[j] - b[j]) * (a[j] - b[j])
+		}
+		return math.Pow(float64(d), -maximinPower/2.0)
+	}
+	partial := func(i, k int) float64 {
+		sum := 0.0
+		for m := range s {
+			if m != i && m != k {
+				sum += term(s[i], s[m]) + term(s[k], s[m])
+			}
+		}
+		return sum
+	}
+	for it := 0; n > 2 && it < iterations; it++ {
+		i, k, j := r.Intn(n), r.Intn(n-1), r.Intn(len(startNums))
+		if k >= i {
+			k++
+		}
+		before := partial(i, k)
+		s[i][j], s[k][j] = s[k][j], s[i][j]
+		if partial(i, k) >= before {
+			s[i][j], s[k][j] = s[k][j], s[i][j]
+		}
+	}
+	return lhsPoints(s, startNums, endNums, r), nil
+}
+
+// Stratum is a box of the sample space along with the number of points which Stratified draws inside it.
+type Stratum struct {
+	StartNums []float64 // the lower bound of the box in each dimension
+	EndNums   []float64 // the upper bound of the box in each dimension
+	N         int       // the number of points to draw inside the box
+}
+
+// Stratified function is used to get stratified samples, each stratum gets its own number of uniform points.
+// All the strata must have the same number of dimensions, they may overlap or leave gaps.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the points of all strata in order in a slice of type [][]float64 and any write error encountered.
+// example: random.Stratified([]random.Stratum{{[]float64{0}, []float64{10}, 90}, {[]float64{10}, []float64{1000}, 10}}, nil), returns 100 points, 90 of them in [0, 10).
+func Stratified(strata []Stratum, r *rand.Rand) ([][]float64, error) {
+	const fn = "Stratified"
+	if len(strata) == 0 {
+		return nil, &Error{fn, ErrEmpty}
+	}
+	total := 0
+	for i, st := range strata {
+		if err := checkRanges(st.StartNums, st.EndNums); err != nil {
+			return nil, &Error{fn, err}
+		}
+		if len(st.StartNums) != len(strata[0].StartNums) {
+			return nil, &Error{fn, fmt.Errorf("%w: stratum %d has a different number of dimensions", ErrInvalid, i)}
+		}
+		if st.N < 0 {
+			return nil, &Error{fn, fmt.Errorf("%w: stratum %d has a negative N", ErrInvalid, i)}
+		}
+		total += st.N
+	}
+	r = rng(r)
+	p := make([][]float64, 0, total)
+	for _, st := range strata {
+		for i := 0; i < st.N; i++ {
+			x := make([]float64, len(st.StartNums))
+			for j := range x {
+				x[j] = st.StartNums[j] + r.Float64()*(st.EndNums[j]-st.StartNums[j])
+			}
+			p = append(p, x)
+		}
+	}
+	return p, nil
+}
+
+// checkRanges is one of the inner functions of this package.
+// It checks that startNums and endNums describe at least one valid range.
+func checkRanges(startNums []float64, endNums []float64) error {
+	if len(startNums) == 0 {
+		return ErrEmpty
+	}
+	if len(startNums) != len(endNums) {
+		return fmt.Errorf("%w: startNums and endNums must have the same length", ErrInvalid)
+	}
+	for j := range startNums {
+		if startNums[j] >= endNums[j] {
+			return ErrEndNumSmaller
+		}
+	}
+	return nil
+}
+
+// latinStrata is one of the inner functions of this package.
+// It returns n rows of dim strata indexes, each column being a random permutation of [0, n).
+func latinStrata(n int, dim int, r *rand.Rand) [][]int {
+	s := make([][]int, n)
+	for i := range s {
+		s[i] = make([]int, dim)
+	}
+	for j := 0; j < dim; j++ {
+		for i, v := range r.Perm(n) {
+			s[i][j] = v
+		}
+	}
+	return s
+}
+
+// lhsPoints is one of the inner functions of this package.
+// It places a uniform point inside the strata of every row and scales it to the given ranges.
+func lhsPoints(s [][]int, startNums []float64, endNums []float64, r *rand.Rand) [][]float64 {
+	n := float64(len(s))
+	p := make([][]float64, len(s))
+	for i, row := range s {
+		p[i] = make([]float64, len(row))
+		for j, v := range row {
+			p[i][j] = startNums[j] + (float64(v)+r.Float64())/n*(endNums[j]-startNums[j])
+		}
+	}
+	return p
+}
@@ -0,0 +1,309 @@
+/*
+ * File: distribution.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Distribution is implemented by everything in this package which draws random values of type T.
+// Sample draws a single value and SampleN draws n values into a new slice.
+// Distributions can be combined with Mixture, Map, Shift, Scale and Truncate to describe data declaratively.
+type Distribution[T any] interface {
+	Sample() T
+	SampleN(n int) []T
+}
+
+// Number is a constraint which permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// DistributionFunc is an adapter to use an ordinary function as a Distribution.
+// example: random.DistributionFunc[bool](random.Bool), returns a Distribution of type bool.
+type DistributionFunc[T any] func() T
+
+// Sample calls f().
+func (f DistributionFunc[T]) Sample() T {
+	return f()
+}
+
+// SampleN calls f() n times.
+func (f DistributionFunc[T]) SampleN(n int) []T {
+	return sampleN[T](f, n)
+}
+
+// UniformInteger is the Distribution of Integer, it draws integers from the range [StartNum, EndNum].
+type UniformInteger struct {
+	StartNum int        // the lowest value which can be drawn
+	EndNum   int        // the highest value which can be drawn
+	Rand     *rand.Rand // the generator used for sampling, nil uses Default()
+}
+
+// NewUniformInteger function is used to get a Distribution drawing integers from the range [startNum, endNum].
+// It returns the distribution of type *UniformInteger and any write error encountered.
+// example: random.NewUniformInteger(1, 6), returns a distribution rolling a dice of 6 faces.
+func NewUniformInteger(startNum int, endNum int) (*UniformInteger, error) {
+	const fn = "NewUniformInteger"
+	if startNum >= endNum {
+		return nil, &Error{fn, ErrEndNumSmaller}
+	}
+	return &UniformInteger{StartNum: startNum, EndNum: endNum}, nil
+}
+
+// Sample draws a random integer from the range [StartNum, EndNum].
+func (u *UniformInteger) Sample() int {
+	return int(int64Range(int64(u.StartNum), int64(u.EndNum), rng(u.Rand)))
+}
+
+// SampleN draws n random integers from the range [StartNum, EndNum].
+func (u *UniformInteger) SampleN(n int) []int {
+	return sampleN[int](u, n)
+}
+
+// UniformFloat64 is the Distribution of Float64, it draws float64 values from the range [StartNum, EndNum).
+type UniformFloat64 struct {
+	StartNum float64    // the lowest value which can be drawn
+	EndNum   float64    // the value up to which values are drawn
+	Rand     *rand.Rand // the generator used for sampling, nil uses Default()
+}
+
+// NewUniformFloat64 function is used to get a Distribution drawing float64 values from the range [startNum, endNum).
+// It returns the distribution of type *UniformFloat64 and any write error encountered.
+// example: random.NewUniformFloat64(1.5, 2.5), returns a distribution drawing values from the range [1.5, 2.5).
+func NewUniformFloat64(startNum float64, endNum float64) (*UniformFloat64, error) {
+	const fn = "NewUniformFloat64"
+	if startNum >= endNum {
+		return nil, &Error{fn, ErrEndNumSmaller}
+	}
+	return &UniformFloat64{StartNum: startNum, EndNum: endNum}, nil
+}
+
+// Sample draws a random float64 value from the range [StartNum, EndNum).
+func (u *UniformFloat64) Sample() float64 {
+	return u.StartNum + rng(u.Rand).Float64()*(u.EndNum-u.StartNum)
+}
+
+// SampleN draws n random float64 values from the range [StartNum, EndNum).
+func (u *UniformFloat64) SampleN(n int) []float64 {
+	return sampleN[float64](u, n)
+}
+
+// Normal is the normal (gaussian) Distribution with the given mean and standard deviation.
+type Normal struct {
+	Mean   float64    // the mean of the distribution
+	StdDev float64    // the standard deviation of the distribution
+	Rand   *rand.Rand // the generator used for sampling, nil uses Default()
+}
+
+// NewNormal function is used to get a normal Distribution with the given mean and standard deviation.
+// It returns the distribution of type *Normal and any write error encountered.
+// example: random.NewNormal(50, 5), returns a distribution drawing values around 50.
+func NewNormal(mean float64, stdDev float64) (*Normal, error) {
+	const fn = "NewNormal"
+	if stdDev < 0 || math.IsNaN(stdDev) {
+		return nil, &Error{fn, fmt.Errorf("%w: stdDev must not be negative", ErrInvalid)}
+	}
+	return &Normal{Mean: mean, StdDev: stdDev}, nil
+}
+
+// Sample draws a normally distributed float64 value.
+func (d *Normal) Sample() float64 {
+	return d.Mean + rng(d.Rand).NormFloat64()*d.StdDev
+}
+
+// SampleN draws n normally distributed float64 values.
+func (d *Normal) SampleN(n int) []float64 {
+	return sampleN[float64](d, n)
+}
+
+// Exponential is the exponential Distribution with the given rate (lambda).
+type Exponential struct {
+	Rate float64    // the rate of the distribution, its mean is 1/Rate
+	Rand *rand.Rand // the generator used for sampling, nil uses Default()
+}
+
+// NewExponential function is used to get an exponential Distribution with the given rate.
+// It returns the distribution of type *Exponential and any write error encountered.
+// example: random.NewExponential(0.5), returns a distribution drawing values with a mean of 2.
+func NewExponential(rate float64) (*Exponential, error) {
+	const fn = "NewExponential"
+	if !(rate > 0) {
+		return nil, &Error{fn, fmt.Errorf("%w: rate must be positive", ErrInvalid)}
+	}
+	return &Exponential{Rate: rate}, nil
+}
+
+// Sample draws an exponentially distributed float64 value.
+func (d *Exponential) Sample() float64 {
+	return rng(d.Rand).ExpFloat64() / d.Rate
+}
+
+// SampleN draws n exponentially distributed float64 values.
+func (d *Exponential) SampleN(n int) []float64 {
+	return sampleN[float64](d, n)
+}
+
+// Pareto is the Pareto (type I) Distribution, a heavy tailed distribution useful to model latency tails.
+type Pareto struct {
+	Scale float64    // the lowest value which can be drawn
+	Shape float64    // the tail index, smaller values give heavier tails
+	Rand  *rand.Rand // the generator used for sampling, nil uses Default()
+}
+
+// NewPareto function is used to get a Pareto Distribution with the given scale and shape.
+// It returns the distribution of type *Pareto and any write error encountered.
+// example: random.NewPareto(100, 1.5), returns a distribution drawing values from 100 upwards with a heavy tail.
+func NewPareto(scale float64, shape float64) (*Pareto, error) {
+	const fn = "NewPareto"
+	if !(scale > 0) || !(shape > 0) {
+		return nil, &Error{fn, fmt.Errorf("%w: scale and shape must be positive", ErrInvalid)}
+	}
+	return &Pareto{Scale: scale, Shape: shape}, nil
+}
+
+// Sample draws a Pareto distributed float64 value.
+func (d *Pareto) Sample() float64 {
+	return d.Scale / math.Pow(1-rng(d.Rand).Float64(), 1/d.Shape)
+}
+
+// SampleN draws n Pareto distributed float64 values.
+func (d *Pareto) SampleN(n int) []float64 {
+	return sampleN[float64](d, n)
+}
+
+// Mixture is a Distribution which draws each value from one of its components, chosen by weight.
+type Mixture[T any] struct {
+	Rand *rand.Rand // the generator used to choose a component, nil uses Default()
+
+	components []Distribution[T]
+	cum        []float64
+}
+
+// NewMixture function is used to combine distributions into a weighted mixture.
+// components and weights must have the same length, weights must not be negative and need not sum to 1.
+// It returns the distribution of type *Mixture[T] and any write error encountered.
+// example: random.NewMixture([]random.Distribution[float64]{normal, pareto}, []float64{0.8, 0.2}), returns a distribution drawing from normal 80% of the time and from pareto 20% of the time.
+func NewMixture[T any](components []Distribution[T], weights []float64) (*Mixture[T], error) {
+	const fn = "NewMixture"
+	if len(components) == 0 {
+		return nil, &Error{fn, ErrEmpty}
+	}
+	if len(components) != len(weights) {
+		return nil, &Error{fn, fmt.Errorf("%w: components and weights must have the same length", ErrInvalid)}
+	}
+	cum := make([]float64, len(weights))
+	total := 0.0
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return nil, &Error{fn, fmt.Errorf("%w: weight %d must be a finite non-negative number", ErrInvalid, i)}
+		}
+		total += w
+		cum[i] = total
+	}
+	if total == 0 {
+		return nil, &Error{fn, fmt.Errorf("%w: weights sum to zero", ErrInvalid)}
+	}
+	return &Mixture[T]{components: append([]Distribution[T](nil), components...), cum: cum}, nil
+}
+
+// Sample chooses a component by weight and draws a value from it.
+func (m *Mixture[T]) Sample() T {
+	target := rng(m.Rand).Float64() * m.cum[len(m.cum)-1]
+	i := sort.Search(len(m.cum), func(i int) bool { return m.cum[i] > target })
+	if i == len(m.cum) {
+		i--
+	}
+	return m.components[i].Sample()
+}
+
+// SampleN draws n values from the mixture.
+func (m *Mixture[T]) SampleN(n int) []T {
+	return sampleN[T](m, n)
+}
+
+// Map function is used to transform the values drawn from a Distribution into another type.
+// It returns a Distribution of type U which applies f to every value drawn from d.
+// example: random.Map[float64, time.Duration](normal, func(v float64) time.Duration { return time.Duration(v * float64(time.Millisecond)) }), returns a distribution of durations.
+func Map[T, U any](d Distribution[T], f func(T) U) Distribution[U] {
+	return DistributionFunc[U](func() U {
+		return f(d.Sample())
+	})
+}
+
+// Shift function is used to add a constant offset to every value drawn from a Distribution.
+// example: random.Shift[float64](exponential, 10), returns a distribution drawing values from 10 upwards.
+func Shift[T Number](d Distribution[T], offset T) Distribution[T] {
+	return Map(d, func(v T) T {
+		return v + offset
+	})
+}
+
+// Scale function is used to multiply every value drawn from a Distribution by a constant factor.
+// example: random.Scale[float64](normal, 1000), returns a distribution with its mean and deviation multiplied by 1000.
+func Scale[T Number](d Distribution[T], factor T) Distribution[T] {
+	return Map(d, func(v T) T {
+		return v * factor
+	})
+}
+
+// maxTruncateTries is the number of draws Truncate makes before it gives up and clamps the value.
+const maxTruncateTries = 1000
+
+// Truncate function is used to restrict a Distribution to the range [startNum, endNum].
+// Values outside the range are drawn again, if no value falls in the range after 1000 draws the last one is clamped to it.
+// It returns the truncated Distribution and any write error encountered.
+// example: random.Truncate[float64](normal, 0, 100), returns a distribution like normal which never draws values outside [0, 100].
+func Truncate[T Number](d Distribution[T], startNum T, endNum T) (Distribution[T], error) {
+	const fn = "Truncate"
+	if startNum >= endNum {
+		return nil, &Error{fn, ErrEndNumSmaller}
+	}
+	return DistributionFunc[T](func() T {
+		var v T
+		for i := 0; i < maxTruncateTries; i++ {
+			v = d.Sample()
+			if v >= startNum && v <= endNum {
+				return v
+			}
+		}
+		if v < startNum {
+			return startNum
+		}
+		return endNum
+	}), nil
+}
+
+// sampleN is one of the inner functions of this package.
+// It draws n values from d into a new slice.
+func sampleN[T any](d interface{ Sample() T }, n int) []T {
+	s := make([]T, n)
+	for i := range s {
+		s[i] = d.Sample()
+	}
+	return s
+}
@@ -0,0 +1,234 @@
+/*
+ * File: empirical.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// EmpiricalMode decides the way an Empirical distribution draws its values.
+type EmpiricalMode int
+
+const (
+	// EmpiricalResample draws one of the observed values as it is (exact resampling).
+	// For a histogram it draws a bin by its count and then a uniform value inside that bin.
+	EmpiricalResample EmpiricalMode = iota
+	// EmpiricalKDE draws from a gaussian kernel density estimate of the observed values.
+	// The width of the kernel is set by the Bandwidth field.
+	EmpiricalKDE
+	// EmpiricalInterpolate draws from the empirical quantile function, interpolating linearly between the observed values.
+	EmpiricalInterpolate
+)
+
+// Empirical is a distribution built from observed data, either raw samples or a histogram.
+// It is useful to generate synthetic data which looks like the data it was built from.
+// Unlike ChoiceFloat64, which can only return stored values, it can also smooth and interpolate between them.
+// It implements Distribution[float64].
+type Empirical struct {
+	Mode      EmpiricalMode // the way values are drawn, EmpiricalResample by default
+	Bandwidth float64       // the kernel bandwidth used by EmpiricalKDE, the constructors set it with Silverman's rule of thumb
+	Rand      *rand.Rand    // the generator used for sampling, nil uses Default()
+
+	values []float64 // sorted samples, or the bin edges of a histogram
+	cum    []float64 // cumulative bin counts of a histogram, nil for raw samples
+}
+
+// NewEmpirical function is used to build an Empirical distribution from observed float64 samples.
+// The samples are copied, so the slice can be reused by the caller.
+// It returns the distribution of type *Empirical and any write error encountered.
+// example: random.NewEmpirical([]float64{1.2, 3.4, 2.2}), returns a distribution drawing values like the given ones.
+func NewEmpirical(samples []float64) (*Empirical, error) {
+	const fn = "NewEmpirical"
+	if len(samples) == 0 {
+		return nil, &Error{fn, ErrEmpty}
+	}
+	values := make([]float64, len(samples))
+	for i, s := range samples {
+		if math.IsNaN(s) || math.IsInf(s, 0) {
+			return nil, &Error{fn, fmt.Errorf("%w: sample %d is not finite", ErrInvalid, i)}
+		}
+		values[i] = s
+	}
+	sort.Float64s(values)
+	e := &Empirical{values: values}
+	e.Bandwidth = e.silverman()
+	return e, nil
+}
+
+// NewEmpiricalInt function is used to build an Empirical distribution from observed int samples.
+// It works the same way as NewEmpirical, use SampleInt to draw integers from it.
+// It returns the distribution of type *Empirical and any write error encountered.
+func NewEmpiricalInt(samples []int) (*Empirical, error) {
+	const fn = "NewEmpiricalInt"
+	if len(samples) == 0 {
+		return nil, &Error{fn, ErrEmpty}
+	}
+	values := make([]float64, len(samples))
+	for i := range samples {
+		values[i] = float64(samples[i])
+	}
+	return NewEmpirical(values)
+}
+
+// NewEmpiricalHistogram function is used to build an Empirical distribution from a histogram.
+// edges (type []float64) are the increasing bin edges, bin i covers the range [edges[i], edges[i+1]).
+// counts (type []int) are the number of observations in each bin, it must have one element less than edges.
+// It returns the distribution of type *Empirical and any write error encountered.
+// example: random.NewEmpiricalHistogram([]float64{0, 10, 20}, []int{3, 1}), returns a distribution drawing from [0, 10) three times as often as from [10, 20).
+func NewEmpiricalHistogram(edges []float64, counts []int) (*Empirical, error) {
+	const fn = "NewEmpiricalHistogram"
+	if len(counts) == 0 {
+		return nil, &Error{fn, ErrEmpty}
+	}
+	if len(edges) != len(counts)+1 {
+		return nil, &Error{fn, fmt.Errorf("%w: edges must have one element more than counts", ErrInvalid)}
+	}
+	cum := make([]float64, len(counts))
+	total := 0.0
+	for i, c := range counts {
+		if c < 0 {
+			return nil, &Error{fn, fmt.Errorf("%w: negative count in bin %d", ErrInvalid, i)}
+		}
+		if !(edges[i] < edges[i+1]) || math.IsInf(edges[i], 0) || math.IsInf(edges[i+1], 0) {
+			return nil, &Error{fn, fmt.Errorf("%w: edges must be finite and increasing", ErrInvalid)}
+		}
+		total += float64(c)
+		cum[i] = total
+	}
+	if total == 0 {
+		return nil, &Error{fn, ErrEmpty}
+	}
+	e := &Empirical{values: append([]float64(nil), edges...), cum: cum}
+	e.Bandwidth = e.silverman()
+	return e, nil
+}
+
+// Quantile function returns the value below which a fraction p of the observed data falls.
+// p is clamped to the range [0, 1], values between the observed ones are interpolated linearly.
+// example: e.Quantile(0.5), returns the median of the data e was built from.
+func (e *Empirical) Quantile(p float64) float64 {
+	if p <= 0 || math.IsNaN(p) {
+		p = 0
+	}
+	if p >= 1 {
+		p = 1
+	}
+	if e.cum == nil {
+		pos := p * float64(len(e.values)-1)
+		i := int(pos)
+		if i >= len(e.values)-1 {
+			return e.values[len(e.values)-1]
+		}
+		return e.values[i] + (pos-float64(i))*(e.values[i+1]-e.values[i])
+	}
+	target := p * e.cum[len(e.cum)-1]
+	i := sort.SearchFloat64s(e.cum, target)
+	if i >= len(e.cum) {
+		i = len(e.cum) - 1
+	}
+	prev := 0.0
+	if i > 0 {
+		prev = e.cum[i-1]
+	}
+	frac := 0.0
+	if e.cum[i] > prev {
+		frac = (target - prev) / (e.cum[i] - prev)
+	}
+	return e.values[i] + frac*(e.values[i+1]-e.values[i])
+}
+
+// Sample function draws a random value from the distribution according to its Mode.
+// It returns the randomly drawn value of type float64.
+func (e *Empirical) Sample() float64 {
+	r := rng(e.Rand)
+	switch e.Mode {
+	case EmpiricalKDE:
+		return e.resample(r) + r.NormFloat64()*e.Bandwidth
+	case EmpiricalInterpolate:
+		return e.Quantile(r.Float64())
+	default:
+		return e.resample(r)
+	}
+}
+
+// SampleN function draws n random values from the distribution according to its Mode.
+// It returns the randomly drawn values in a slice of type []float64.
+func (e *Empirical) SampleN(n int) []float64 {
+	return sampleN[float64](e, n)
+}
+
+// SampleInt function draws a random value from the distribution rounded to the nearest integer.
+// It is meant to be used with distributions built by NewEmpiricalInt.
+func (e *Empirical) SampleInt() int {
+	return int(math.Round(e.Sample()))
+}
+
+// resample is one of the inner functions of this package.
+// It draws an observed value, or a uniform value inside a randomly drawn bin of a histogram.
+func (e *Empirical) resample(r *rand.Rand) float64 {
+	if e.cum == nil {
+		return e.values[r.Intn(len(e.values))]
+	}
+	return e.Quantile(r.Float64())
+}
+
+// silverman is one of the inner functions of this package.
+// It returns the kernel bandwidth suggested by Silverman's rule of thumb for the observed data.
+func (e *Empirical) silverman() float64 {
+	var n, mean, sq float64
+	if e.cum == nil {
+		n = float64(len(e.values))
+		for _, v := range e.values {
+			mean += v
+		}
+		mean /= n
+		for _, v := range e.values {
+			sq += (v - mean) * (v - mean)
+		}
+	} else {
+		prev := 0.0
+		n = e.cum[len(e.cum)-1]
+		for i, c := range e.cum {
+			mean += (c - prev) * (e.values[i] + e.values[i+1]) / 2
+			prev = c
+		}
+		mean /= n
+		prev = 0
+		for i, c := range e.cum {
+			mid := (e.values[i] + e.values[i+1]) / 2
+			sq += (c - prev) * (mid - mean) * (mid - mean)
+			prev = c
+		}
+	}
+	if n < 2 {
+		return 0
+	}
+	sd := math.Sqrt(sq / (n - 1))
+	spread := sd
+	if iqr := (e.Quantile(0.75) - e.Quantile(0.25)) / 1.34; iqr > 0 && iqr < sd {
+		spread = iqr
+	}
+	return 0.9 * spread * math.Pow(n, -0.2)
+}
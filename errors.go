@@ -33,6 +33,13 @@ func (e *Error) Error() string {
 	return "random." + e.Func + ": " + e.Err.Error()
 }
 
+// Unwrap returns the reason of the error, so that errors.Is can match the sentinel errors like ErrExceed.
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
 var ErrExceed = errors.New("n exceeded a")
 var ErrUnsupported = errors.New("unsupported type")
 var ErrEndNumSmaller = errors.New("endNum must be greater than startNum")
+var ErrEmpty = errors.New("no values to choose from")
+var ErrInvalid = errors.New("invalid argument")
@@ -26,6 +26,8 @@ import (
 	"strings"
 
 	"github.com/anonyindian/random-go"
+	"github.com/anonyindian/random-go/fake"
+	"github.com/anonyindian/random-go/text"
 )
 
 func main() {
@@ -79,3 +81,25 @@ func ChooseRandomPersonWithProfession() string {
 func JumbleSentence(sentence string) string {
 	return strings.Join(random.ShuffleStrings(strings.Fields(sentence)), " ")
 }
+
+// A function to fill a UI fixture with placeholder text.
+// text.Lorem was used with a seeded generator, so the same seed always gives the same text.
+func PlaceholderText(seed int64) string {
+	l := &text.Lorem{Rand: random.NewRand(seed)}
+	p, _ := l.Paragraphs(2)
+	return p
+}
+
+// A function to make a reproducible set of user fixtures.
+// fake.New was used with a seeded generator, so the same seed always gives the same users.
+func FakeUsers(n int, seed int64) []string {
+	f, err := fake.New("en_US", random.NewRand(seed))
+	if err != nil {
+		return nil
+	}
+	users := make([]string, n)
+	for i := range users {
+		users[i] = f.Name() + " <" + f.Email() + ">, " + f.JobTitle()
+	}
+	return users
+}
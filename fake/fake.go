@@ -0,0 +1,277 @@
+/*
+ * File: fake.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+// Package fake generates fake personal and business data for fixtures: names, usernames, emails,
+// addresses, phone numbers, companies and job titles, from datasets embedded for every locale.
+// Emails use the reserved example domains and phone numbers use the ranges set aside for fiction where a locale has them.
+// A Faker with a seeded generator produces the same data every time, so fixture sets are reproducible.
+package fake
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/anonyindian/random-go"
+)
+
+//go:embed data/*.json
+var data embed.FS
+
+// dataset is the data of one locale, as stored in data/<locale>.json.
+type dataset struct {
+	FirstNames     []string    `json:"first_names"`
+	LastNames      []string    `json:"last_names"`
+	Streets        []string    `json:"streets"`
+	StreetSuffixes []string    `json:"street_suffixes"`
+	Cities         [][2]string `json:"cities"` // pairs of city and region
+	PostalCodes    []string    `json:"postal_codes"`
+	PhoneNumbers   []string    `json:"phone_numbers"`
+	StreetAddress  string      `json:"street_address"`
+	Address        string      `json:"address"`
+	Companies      []string    `json:"companies"`
+	JobTitles      []string    `json:"job_titles"`
+	Domains        []string    `json:"domains"`
+}
+
+// DefaultLocale is the locale used by New when locale is empty.
+const DefaultLocale = "en_US"
+
+// Locales function returns the names of the embedded locales, like "en_US" and "de_DE".
+func Locales() []string {
+	entries, _ := data.ReadDir("data")
+	names := make([]string, 0, len(entries))
+	for _, e := range entries {
+		names = append(names, strings.TrimSuffix(e.Name(), ".json"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Faker generates fake data of one locale.
+// It is not safe for concurrent use when it has a Rand, as *rand.Rand isn't.
+type Faker struct {
+	Rand *rand.Rand // the generator used, nil uses the package-level functions of the random package
+
+	data *dataset
+}
+
+// New function is used to get a Faker for locale, one of Locales().
+// r (type *rand.Rand) is the generator used, a seeded one makes the data reproducible and nil uses the random package's default.
+// It returns the faker of type *Faker and any write error encountered.
+// example: fake.New("en_GB", random.NewRand(42)), returns a faker which always generates the same british data.
+func New(locale string, r *rand.Rand) (*Faker, error) {
+	const fn = "New"
+	if locale == "" {
+		locale = DefaultLocale
+	}
+	b, err := data.ReadFile("data/" + locale + ".json")
+	if err != nil {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: unknown locale %q", random.ErrInvalid, locale)}
+	}
+	d := new(dataset)
+	if err := json.Unmarshal(b, d); err != nil {
+		return nil, &random.Error{Func: fn, Err: err}
+	}
+	return &Faker{Rand: r, data: d}, nil
+}
+
+// FirstName function returns a random first name.
+func (f *Faker) FirstName() string {
+	return f.choice(f.data.FirstNames)
+}
+
+// LastName function returns a random last name.
+func (f *Faker) LastName() string {
+	return f.choice(f.data.LastNames)
+}
+
+// Name function returns a random full name, a first name followed by a last name.
+// example: f.Name(), returns a name like "Olivia Martinez".
+func (f *Faker) Name() string {
+	return f.FirstName() + " " + f.LastName()
+}
+
+// Username function returns a random username made of ASCII letters, digits, dots and underscores.
+// example: f.Username(), returns a username like "olivia.martinez" or "jmueller87".
+func (f *Faker) Username() string {
+	first, last := ascii(f.FirstName()), ascii(f.LastName())
+	switch f.intn(4) {
+	case 0:
+		return first + "." + last
+	case 1:
+		return first + "_" + last + strconv.Itoa(f.intn(100))
+	case 2:
+		return first[:1] + last + strconv.Itoa(10+f.intn(90))
+	default:
+		return last + strconv.Itoa(1950+f.intn(60))
+	}
+}
+
+// Email function returns a random email address at one of the reserved example domains.
+// example: f.Email(), returns an address like "olivia.martinez@example.org".
+func (f *Faker) Email() string {
+	return f.Username() + "@" + f.choice(f.data.Domains)
+}
+
+// StreetAddress function returns a random street address, in the format of the locale.
+// example: f.StreetAddress(), returns an address like "742 Maple Avenue" for en_US and "Lindenstraße 12" for de_DE.
+func (f *Faker) StreetAddress() string {
+	return f.expand(f.data.StreetAddress, nil)
+}
+
+// City function returns a random city.
+func (f *Faker) City() string {
+	return f.data.Cities[f.intn(len(f.data.Cities))][0]
+}
+
+// PostalCode function returns a random postal code, in the format of the locale.
+func (f *Faker) PostalCode() string {
+	return f.pattern(f.choice(f.data.PostalCodes))
+}
+
+// Address function returns a random full postal address on several lines, in the format of the locale.
+// The city and the region of the address belong together.
+// example: f.Address(), returns an address like "742 Maple Avenue\nAustin, TX 73301".
+func (f *Faker) Address() string {
+	return f.expand(f.data.Address, f.data.Cities[f.intn(len(f.data.Cities))][:])
+}
+
+// PhoneNumber function returns a random phone number, in the format of the locale.
+func (f *Faker) PhoneNumber() string {
+	return f.pattern(f.choice(f.data.PhoneNumbers))
+}
+
+// Company function returns a random company name.
+// example: f.Company(), returns a name like "Walker & Young" or "Hoffmann GmbH".
+func (f *Faker) Company() string {
+	return f.expand(f.choice(f.data.Companies), nil)
+}
+
+// JobTitle function returns a random job title.
+func (f *Faker) JobTitle() string {
+	return f.choice(f.data.JobTitles)
+}
+
+// choice is one of the inner functions of this package.
+// It returns a random element of a, with random.ChoiceString unless f has its own generator.
+func (f *Faker) choice(a []string) string {
+	if len(a) == 0 {
+		return ""
+	}
+	if f.Rand == nil {
+		return random.ChoiceString(a)
+	}
+	return a[f.Rand.Intn(len(a))]
+}
+
+// intn is one of the inner functions of this package.
+// It returns a random integer from the range [0, n).
+func (f *Faker) intn(n int) int {
+	if f.Rand == nil {
+		return random.Default().Intn(n)
+	}
+	return f.Rand.Intn(n)
+}
+
+// pattern is one of the inner functions of this package.
+// It replaces every # of p with a random digit and every ? with a random uppercase letter.
+func (f *Faker) pattern(p string) string {
+	b := []byte(p)
+	for i, c := range b {
+		switch c {
+		case '#':
+			b[i] = byte('0' + f.intn(10))
+		case '?':
+			b[i] = byte('A' + f.intn(26))
+		}
+	}
+	return string(b)
+}
+
+// expand is one of the inner functions of this package.
+// It replaces every placeholder like {last} of format with a random value, each occurrence gets its own value.
+// city holds the city and region to use, nil draws them.
+func (f *Faker) expand(format string, city []string) string {
+	if city == nil {
+		city = f.data.Cities[f.intn(len(f.data.Cities))][:]
+	}
+	var b strings.Builder
+	for {
+		i := strings.IndexByte(format, '{')
+		j := strings.IndexByte(format, '}')
+		if i < 0 || j < i {
+			b.WriteString(format)
+			return b.String()
+		}
+		b.WriteString(format[:i])
+		switch format[i+1 : j] {
+		case "first":
+			b.WriteString(f.FirstName())
+		case "last":
+			b.WriteString(f.LastName())
+		case "number":
+			b.WriteString(strconv.Itoa(1 + f.intn(999)))
+		case "street":
+			b.WriteString(f.choice(f.data.Streets))
+		case "suffix":
+			b.WriteString(f.choice(f.data.StreetSuffixes))
+		case "street_address":
+			b.WriteString(f.StreetAddress())
+		case "city":
+			b.WriteString(city[0])
+		case "region":
+			b.WriteString(city[1])
+		case "postal_code":
+			b.WriteString(f.PostalCode())
+		}
+		format = format[j+1:]
+	}
+}
+
+// ascii is one of the inner functions of this package.
+// It lowercases s and transliterates it to ASCII letters, leaving out anything else.
+func ascii(s string) string {
+	var b strings.Builder
+	for _, c := range strings.ToLower(s) {
+		switch {
+		case c >= 'a' && c <= 'z':
+			b.WriteRune(c)
+		case c == 'ä':
+			b.WriteString("ae")
+		case c == 'ö':
+			b.WriteString("oe")
+		case c == 'ü':
+			b.WriteString("ue")
+		case c == 'ß':
+			b.WriteString("ss")
+		}
+	}
+	if b.Len() == 0 {
+		return "user"
+	}
+	return b.String()
+}
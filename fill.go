@@ -0,0 +1,441 @@
+/*
+ * File: fill.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// The defaults of Fill for the values which have no tag.
+const (
+	fillMaxNum       = 1000 // numbers are drawn from [0, fillMaxNum], capped at the largest value of their type
+	fillMinStringLen = 8    // strings have fillMinStringLen to fillMaxStringLen alphanumeric characters
+	fillMaxStringLen = 16
+	fillMinLen       = 1 // slices and maps have fillMinLen to fillMaxLen elements
+	fillMaxLen       = 5
+	fillMaxDepth     = 5 // the default of Filler.MaxDepth
+)
+
+var timeType = reflect.TypeOf(time.Time{})
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Filler fills structs, slices, maps and other values with random data, see Fill for the rules.
+// Its methods are safe for concurrent use when Rand is nil.
+type Filler struct {
+	Rand     *rand.Rand // the generator used, nil uses Default()
+	MaxDepth int        // the number of pointers, slices and maps followed into recursive types, 5 if it is 0
+
+	mu        sync.RWMutex
+	providers map[reflect.Type]func(r *rand.Rand) reflect.Value
+}
+
+// defaultFiller is the Filler used by Fill.
+var defaultFiller = &Filler{}
+
+// fillTag holds the settings of a `random:"..."` struct tag.
+type fillTag struct {
+	skip     bool
+	min, max string
+	length   string
+	oneof    []string
+}
+
+// RegisterProvider function is used to make f fill every value of type T with p, instead of the default rules or tags.
+// f nil registers p for Fill.
+// example: random.RegisterProvider(nil, func(r *rand.Rand) Currency { return currencies[r.Intn(len(currencies))] }), fills every Currency with a known code.
+func RegisterProvider[T any](f *Filler, p func(r *rand.Rand) T) {
+	if f == nil {
+		f = defaultFiller
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.providers == nil {
+		f.providers = make(map[reflect.Type]func(r *rand.Rand) reflect.Value)
+	}
+	f.providers[reflect.TypeOf((*T)(nil)).Elem()] = func(r *rand.Rand) reflect.Value {
+		return reflect.ValueOf(p(r))
+	}
+}
+
+// Fill function is used to fill the value ptr points to with random data, following its type and struct tags.
+// Structs, slices, arrays, maps, pointers and nested types are walked with reflect, unexported fields are left alone.
+// By default numbers are drawn from [0, 1000], or up to the largest value of a smaller type like int8, strings have 8 to 16 alphanumeric characters, slices and maps have
+// 1 to 5 elements and times are drawn from the last year. Struct fields can change that with tags:
+//
+//	Age    int      `random:"min=18,max=99"`       // bounds of a number, a time.Duration or a time.Time (RFC 3339); a min above the default range keeps its span
+//	Code   string   `random:"len=8"`               // exact length of a string, slice or map; min and max bound it
+//	Status string   `random:"oneof=new|paid|sent"` // one of the values, for the elements of slices and arrays too
+//	Cache  []byte   `random:"-"`                   // left alone
+//
+// Kinds which can't be generated, like channels, functions and interfaces, are reported with ErrUnsupported,
+// unless a provider was registered for their type with RegisterProvider.
+// It returns any write error encountered.
+// example: random.Fill(&order), fills every field of order with random data.
+func Fill(ptr interface{}) error {
+	return defaultFiller.Fill(ptr)
+}
+
+// Fill function is used to fill the value ptr points to with random data, like the package-level Fill.
+func (f *Filler) Fill(ptr interface{}) error {
+	const fn = "Fill"
+	v := reflect.ValueOf(ptr)
+	if v.Kind() != reflect.Ptr || v.IsNil() {
+		return &Error{fn, fmt.Errorf("%w: need a non-nil pointer", ErrInvalid)}
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if err := f.fill(v.Elem(), fillTag{}, v.Elem().Type().String(), 0, rng(f.Rand)); err != nil {
+		return &Error{fn, err}
+	}
+	return nil
+}
+
+// fill is one of the inner functions of this package.
+// It fills v following tag, path names v in errors and depth counts the pointers, slices and maps followed so far.
+func (f *Filler) fill(v reflect.Value, tag fillTag, path string, depth int, r *rand.Rand) error {
+	if p, ok := f.providers[v.Type()]; ok {
+		v.Set(p(r))
+		return nil
+	}
+	if len(tag.oneof) > 0 && v.Kind() != reflect.Slice && v.Kind() != reflect.Array && v.Kind() != reflect.Ptr {
+		x, err := parseFillValue(tag.oneof[r.Intn(len(tag.oneof))], v.Type())
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		v.Set(x)
+		return nil
+	}
+	maxDepth := f.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = fillMaxDepth
+	}
+	switch v.Kind() {
+	case reflect.Bool:
+		v.SetBool(r.Intn(2) == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		lo, hi := reflect.ValueOf(int64(0)), reflect.ValueOf(int64(fillMaxNum))
+		if err := fillBounds(tag, v.Type(), &lo, &hi); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		n := int64Range(lo.Int(), hi.Int(), r)
+		if v.OverflowInt(n) {
+			return fmt.Errorf("%s: %w: the bounds overflow %s", path, ErrInvalid, v.Type())
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		lo, hi := reflect.ValueOf(uint64(0)), reflect.ValueOf(uint64(fillMaxNum))
+		if err := fillBounds(tag, v.Type(), &lo, &hi); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		// int64Range works on the two's complement span, so it covers spans above math.MaxInt64 too.
+		n := lo.Uint() + uint64(int64Range(0, int64(hi.Uint()-lo.Uint()), r))
+		if v.OverflowUint(n) {
+			return fmt.Errorf("%s: %w: the bounds overflow %s", path, ErrInvalid, v.Type())
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		lo, hi := reflect.ValueOf(0.0), reflect.ValueOf(float64(fillMaxNum))
+		if err := fillBounds(tag, v.Type(), &lo, &hi); err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		v.SetFloat(lo.Float() + r.Float64()*(hi.Float()-lo.Float()))
+	case reflect.Complex64, reflect.Complex128:
+		v.SetComplex(complex(r.Float64()*fillMaxNum, r.Float64()*fillMaxNum))
+	case reflect.String:
+		n, err := fillLen(tag, fillMinStringLen, fillMaxStringLen, r)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		s := make([]rune, n)
+		for i := range s {
+			s[i] = AlphabetAlnum[r.Intn(len(AlphabetAlnum))]
+		}
+		v.SetString(string(s))
+	case reflect.Struct:
+		if v.Type() == timeType {
+			now := time.Now()
+			lo, hi := reflect.ValueOf(now.AddDate(-1, 0, 0)), reflect.ValueOf(now)
+			if err := fillBounds(tag, v.Type(), &lo, &hi); err != nil {
+				return fmt.Errorf("%s: %w", path, err)
+			}
+			t, _ := Time(lo.Interface().(time.Time), hi.Interface().(time.Time), r)
+			v.Set(reflect.ValueOf(t))
+			return nil
+		}
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Type().Field(i)
+			if !v.Field(i).CanSet() {
+				continue
+			}
+			t, err := parseFillTag(field.Tag.Get("random"))
+			if err != nil {
+				return fmt.Errorf("%s.%s: %w", path, field.Name, err)
+			}
+			if t.skip {
+				continue
+			}
+			if err := f.fill(v.Field(i), t, path+"."+field.Name, depth, r); err != nil {
+				return err
+			}
+		}
+	case reflect.Ptr:
+		if depth >= maxDepth {
+			return nil
+		}
+		x := reflect.New(v.Type().Elem())
+		if err := f.fill(x.Elem(), tag, path, depth+1, r); err != nil {
+			return err
+		}
+		v.Set(x)
+	case reflect.Array:
+		elem := fillTag{oneof: tag.oneof}
+		for i := 0; i < v.Len(); i++ {
+			if err := f.fill(v.Index(i), elem, fmt.Sprintf("%s[%d]", path, i), depth, r); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		if depth >= maxDepth {
+			return nil
+		}
+		n, err := fillLen(tag, fillMinLen, fillMaxLen, r)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		s := reflect.MakeSlice(v.Type(), n, n)
+		elem := fillTag{oneof: tag.oneof}
+		for i := 0; i < n; i++ {
+			if err := f.fill(s.Index(i), elem, fmt.Sprintf("%s[%d]", path, i), depth+1, r); err != nil {
+				return err
+			}
+		}
+		v.Set(s)
+	case reflect.Map:
+		if depth >= maxDepth {
+			return nil
+		}
+		n, err := fillLen(tag, fillMinLen, fillMaxLen, r)
+		if err != nil {
+			return fmt.Errorf("%s: %w", path, err)
+		}
+		m := reflect.MakeMapWithSize(v.Type(), n)
+		// Keys of small types, like bool, may run out before n distinct ones are drawn.
+		for tries := 0; m.Len() < n && tries < 10*n; tries++ {
+			k := reflect.New(v.Type().Key()).Elem()
+			if err := f.fill(k, fillTag{}, path+"[key]", depth+1, r); err != nil {
+				return err
+			}
+			e := reflect.New(v.Type().Elem()).Elem()
+			if err := f.fill(e, fillTag{}, fmt.Sprintf("%s[%v]", path, k), depth+1, r); err != nil {
+				return err
+			}
+			m.SetMapIndex(k, e)
+		}
+		v.Set(m)
+	default:
+		return fmt.Errorf("%s: %w: kind %s", path, ErrUnsupported, v.Kind())
+	}
+	return nil
+}
+
+// parseFillTag is one of the inner functions of this package.
+// It parses a `random:"..."` struct tag.
+func parseFillTag(s string) (fillTag, error) {
+	var t fillTag
+	if s == "" {
+		return t, nil
+	}
+	if s == "-" {
+		t.skip = true
+		return t, nil
+	}
+	for _, kv := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(kv, "=")
+		if !ok {
+			return t, fmt.Errorf("%w: tag option %q has no value", ErrInvalid, kv)
+		}
+		switch k = strings.TrimSpace(k); k {
+		case "min":
+			t.min = v
+		case "max":
+			t.max = v
+		case "len":
+			t.length = v
+		case "oneof":
+			t.oneof = strings.Split(v, "|")
+		default:
+			return t, fmt.Errorf("%w: unknown tag option %q", ErrInvalid, k)
+		}
+	}
+	return t, nil
+}
+
+// parseFillValue is one of the inner functions of this package.
+// It parses s into a value of type typ, durations and times use the formats of package time.
+func parseFillValue(s string, typ reflect.Type) (reflect.Value, error) {
+	v := reflect.New(typ).Elem()
+	var err error
+	switch {
+	case typ == durationType:
+		var d time.Duration
+		d, err = time.ParseDuration(s)
+		v.SetInt(int64(d))
+	case typ == timeType:
+		var t time.Time
+		t, err = time.Parse(time.RFC3339, s)
+		v.Set(reflect.ValueOf(t))
+	default:
+		switch typ.Kind() {
+		case reflect.String:
+			v.SetString(s)
+		case reflect.Bool:
+			var b bool
+			b, err = strconv.ParseBool(s)
+			v.SetBool(b)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			var n int64
+			n, err = strconv.ParseInt(s, 10, typ.Bits())
+			v.SetInt(n)
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			var n uint64
+			n, err = strconv.ParseUint(s, 10, typ.Bits())
+			v.SetUint(n)
+		case reflect.Float32, reflect.Float64:
+			var x float64
+			x, err = strconv.ParseFloat(s, typ.Bits())
+			v.SetFloat(x)
+		default:
+			return v, fmt.Errorf("%w: oneof for kind %s", ErrUnsupported, typ.Kind())
+		}
+	}
+	if err != nil {
+		return v, fmt.Errorf("%w: %v", ErrInvalid, err)
+	}
+	return v, nil
+}
+
+// fillBounds is one of the inner functions of this package.
+// It replaces lo and hi, which hold the default bounds as int64, uint64, float64 or time.Time, with the min and max of tag.
+// The default hi is capped at the largest value of typ, and a min above it moves hi up by the default span,
+// saturating at the largest value of typ, like fillLen does for lengths.
+func fillBounds(tag fillTag, typ reflect.Type, lo *reflect.Value, hi *reflect.Value) error {
+	switch lo.Kind() {
+	case reflect.Int64:
+		if max := int64(math.MaxInt64 >> (64 - typ.Bits())); hi.Int() > max {
+			*hi = reflect.ValueOf(max)
+		}
+	case reflect.Uint64:
+		if max := uint64(math.MaxUint64) >> (64 - typ.Bits()); hi.Uint() > max {
+			*hi = reflect.ValueOf(max)
+		}
+	}
+	defLo, defHi := *lo, *hi
+	for _, b := range []struct {
+		s string
+		v *reflect.Value
+	}{{tag.min, lo}, {tag.max, hi}} {
+		if b.s == "" {
+			continue
+		}
+		x, err := parseFillValue(b.s, typ)
+		if err != nil {
+			return err
+		}
+		*b.v = x.Convert(b.v.Type())
+	}
+	raise := tag.min != "" && tag.max == ""
+	var smaller bool
+	switch lo.Kind() {
+	case reflect.Int64:
+		if raise && hi.Int() < lo.Int() {
+			span, max := defHi.Int()-defLo.Int(), int64(math.MaxInt64>>(64-typ.Bits()))
+			if lo.Int() > max-span {
+				*hi = reflect.ValueOf(max)
+			} else {
+				*hi = reflect.ValueOf(lo.Int() + span)
+			}
+		}
+		smaller = hi.Int() < lo.Int()
+	case reflect.Uint64:
+		if raise && hi.Uint() < lo.Uint() {
+			span, max := defHi.Uint()-defLo.Uint(), uint64(math.MaxUint64)>>(64-typ.Bits())
+			if lo.Uint() > max-span {
+				*hi = reflect.ValueOf(max)
+			} else {
+				*hi = reflect.ValueOf(lo.Uint() + span)
+			}
+		}
+		smaller = hi.Uint() < lo.Uint()
+	case reflect.Float64:
+		if raise && hi.Float() < lo.Float() {
+			*hi = reflect.ValueOf(lo.Float() + defHi.Float() - defLo.Float())
+		}
+		smaller = hi.Float() < lo.Float() || math.IsInf(hi.Float()-lo.Float(), 0)
+	default:
+		l, h := lo.Interface().(time.Time), hi.Interface().(time.Time)
+		if raise && !h.After(l) {
+			*hi = reflect.ValueOf(l.Add(defHi.Interface().(time.Time).Sub(defLo.Interface().(time.Time))))
+		}
+		smaller = !hi.Interface().(time.Time).After(lo.Interface().(time.Time))
+	}
+	if smaller {
+		return ErrEndNumSmaller
+	}
+	return nil
+}
+
+// fillLen is one of the inner functions of this package.
+// It returns a random length following the len, min and max of tag, with the defaults [min, max].
+func fillLen(tag fillTag, min int, max int, r *rand.Rand) (int, error) {
+	if tag.length != "" {
+		n, err := strconv.Atoi(tag.length)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("%w: len=%s", ErrInvalid, tag.length)
+		}
+		return n, nil
+	}
+	if tag.min != "" {
+		n, err := strconv.Atoi(tag.min)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf("%w: min=%s", ErrInvalid, tag.min)
+		}
+		min = n
+		if max < min {
+			max = min
+		}
+	}
+	if tag.max != "" {
+		n, err := strconv.Atoi(tag.max)
+		if err != nil || n < min {
+			return 0, fmt.Errorf("%w: max=%s", ErrInvalid, tag.max)
+		}
+		max = n
+	}
+	return min + r.Intn(max-min+1), nil
+}
@@ -0,0 +1,69 @@
+/*
+ * File: fill_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random_test
+
+import (
+	"testing"
+
+	"github.com/anonyindian/random-go"
+)
+
+func TestFillSmallKinds(t *testing.T) {
+	var v struct {
+		I8    int8
+		U8    uint8
+		I16   int16
+		Bytes []byte `random:"len=64"`
+		Array [16]uint8
+		Min   int8   `random:"min=100"`
+		Big   uint16 `random:"min=2000"`
+		Top   uint8  `random:"min=255"`
+	}
+	f := &random.Filler{Rand: random.NewRand(1)}
+	var wide bool
+	for i := 0; i < 200; i++ {
+		if err := f.Fill(&v); err != nil {
+			t.Fatalf("Fill: %v", err)
+		}
+		if v.I8 < 0 || v.I16 < 0 || v.I16 > 1000 {
+			t.Fatalf("I8 = %d, I16 = %d", v.I8, v.I16)
+		}
+		if len(v.Bytes) != 64 {
+			t.Fatalf("len(Bytes) = %d", len(v.Bytes))
+		}
+		for _, b := range append(v.Bytes, v.Array[:]...) {
+			wide = wide || b > 200
+		}
+		if v.Min < 100 {
+			t.Fatalf("Min = %d, want at least 100", v.Min)
+		}
+		if v.Big < 2000 || v.Big > 3000 {
+			t.Fatalf("Big = %d, want it in [2000, 3000]", v.Big)
+		}
+		if v.Top != 255 {
+			t.Fatalf("Top = %d, want 255", v.Top)
+		}
+	}
+	if !wide {
+		t.Error("no byte above 200 was drawn, the default range of uint8 is too narrow")
+	}
+}
@@ -0,0 +1,176 @@
+/*
+ * File: fuzz.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+)
+
+// ByteSource is a rand.Source64 which reads its values from a byte slice, 8 bytes at a time in big-endian order.
+// Once the bytes run out it goes on with a fixed pseudo-random sequence, so every input, even an empty one,
+// gives a complete and deterministic sequence. Zeros wouldn't do, some methods of rand.Rand never return on them.
+// It lets a fuzzer steer random decisions through its input, see NewFuzz.
+type ByteSource struct {
+	data []byte
+	pos  int
+	tail uint64 // the state of the sequence after the bytes
+}
+
+// NewByteSource function is used to get a ByteSource reading from data.
+// example: rand.New(random.NewByteSource(data)), returns a generator whose values are decoded from data.
+func NewByteSource(data []byte) *ByteSource {
+	return &ByteSource{data: data}
+}
+
+// Uint64 returns the next 8 bytes as a uint64, missing bytes of the last value count as zeros.
+func (s *ByteSource) Uint64() uint64 {
+	if s.pos >= len(s.data) {
+		// splitmix64, a tiny generator whose output has no long runs of zeros.
+		s.tail += 0x9e3779b97f4a7c15
+		z := s.tail
+		z = (z ^ z>>30) * 0xbf58476d1ce4e5b9
+		z = (z ^ z>>27) * 0x94d049bb133111eb
+		return z ^ z>>31
+	}
+	var x uint64
+	for i := 0; i < 8; i++ {
+		x <<= 8
+		if s.pos < len(s.data) {
+			x |= uint64(s.data[s.pos])
+			s.pos++
+		}
+	}
+	return x
+}
+
+// Int63 returns the next 8 bytes as a non-negative int64.
+func (s *ByteSource) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+// Seed does nothing, the values of a ByteSource come from its bytes.
+func (s *ByteSource) Seed(int64) {}
+
+// Exhausted reports whether all the bytes were read.
+func (s *ByteSource) Exhausted() bool {
+	return s.pos >= len(s.data)
+}
+
+// Fuzz decodes the input of a fuzz test into random decisions.
+// Its methods mirror Integer, ChoiceN, Shuffle and friends, but draw from a ByteSource, so the same input
+// always gives the same values and a fuzzer's mutations of the input explore different values.
+// example:
+//
+//	f.Fuzz(func(t *testing.T, data []byte) {
+//		z := random.NewFuzz(data)
+//		n, _ := z.Integer(1, 10)
+//		ops, _ := z.ChoiceN(2, "get", "put", "delete")
+//		...
+//	})
+type Fuzz struct {
+	src *ByteSource
+	r   *rand.Rand
+}
+
+// NewFuzz function is used to get a Fuzz decoding data, typically the []byte argument of a fuzz target.
+func NewFuzz(data []byte) *Fuzz {
+	src := NewByteSource(data)
+	return &Fuzz{src, rand.New(src)}
+}
+
+// Rand returns the generator backed by the input, to drive any function of this package which takes a *rand.Rand.
+// example: random.LatinHypercube(10, lo, hi, z.Rand()), returns a sample decoded from the fuzz input.
+func (z *Fuzz) Rand() *rand.Rand {
+	return z.r
+}
+
+// Exhausted reports whether all the input was read, fuzz targets may stop generating values then.
+func (z *Fuzz) Exhausted() bool {
+	return z.src.Exhausted()
+}
+
+// Bool returns a bool decoded from the input.
+func (z *Fuzz) Bool() bool {
+	return z.r.Intn(2) == 1
+}
+
+// Integer function is used to get an integer between a range [startNum, endNum] decoded from the input, like Integer.
+// It returns the chosen value of type int and any write error encountered.
+func (z *Fuzz) Integer(startNum int, endNum int) (int, error) {
+	const fn = "Fuzz.Integer"
+	if startNum >= endNum {
+		return 0, &Error{fn, ErrEndNumSmaller}
+	}
+	return int(int64Range(int64(startNum), int64(endNum), z.r)), nil
+}
+
+// Float64 function is used to get a float64 value between a range [startNum, endNum) decoded from the input, like Float64.
+// It returns the chosen value of type float64 and any write error encountered.
+func (z *Fuzz) Float64(startNum float64, endNum float64) (float64, error) {
+	const fn = "Fuzz.Float64"
+	if startNum >= endNum {
+		return 0, &Error{fn, ErrEndNumSmaller}
+	}
+	return startNum + z.r.Float64()*(endNum-startNum), nil
+}
+
+// Choice picks one of its parameters following the input, like Choice.
+func (z *Fuzz) Choice(a ...interface{}) interface{} {
+	return a[z.r.Intn(len(a))]
+}
+
+// ChoiceN function is used to pick n distinct parameters following the input, like ChoiceN.
+// It returns the chosen values in a slice of type []interface{} and any write error encountered.
+func (z *Fuzz) ChoiceN(n int, a ...interface{}) ([]interface{}, error) {
+	const fn = "Fuzz.ChoiceN"
+	if n < 0 {
+		return nil, &Error{fn, fmt.Errorf("%w: n must not be negative", ErrInvalid)}
+	}
+	if n > len(a) {
+		return nil, &Error{fn, ErrExceed}
+	}
+	for _, x := range a {
+		if t := reflect.TypeOf(x); t != nil && len(a) > 1 && (t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+			return nil, &Error{fn, fmt.Errorf("%w: %s", ErrUnsupported, t.Kind())}
+		}
+	}
+	rest := append([]interface{}(nil), a...)
+	cs := make([]interface{}, n)
+	for i := range cs {
+		j := z.r.Intn(len(rest))
+		cs[i] = rest[j]
+		rest[j] = rest[len(rest)-1]
+		rest = rest[:len(rest)-1]
+	}
+	return cs, nil
+}
+
+// Shuffle reorders its parameters following the input, like Shuffle.
+// It returns the shuffled slice of type []interface{}.
+func (z *Fuzz) Shuffle(a ...interface{}) []interface{} {
+	z.r.Shuffle(len(a), func(i, j int) {
+		a[i], a[j] = a[j], a[i]
+	})
+	return a
+}
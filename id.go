@@ -0,0 +1,349 @@
+/*
+ * File: id.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"strings"
+	"sync"
+	"time"
+)
+
+// UUID is a universally unique identifier as described in RFC 9562.
+type UUID [16]byte
+
+// ULID is a universally unique lexicographically sortable identifier, a 48 bit timestamp followed by 80 random bits.
+type ULID [16]byte
+
+// DefaultNanoIDSize is the size of the NanoIDs made by NanoID when size is 0.
+const DefaultNanoIDSize = 21
+
+// IDGenerator generates UUIDs, ULIDs and NanoIDs from a chosen generator and clock,
+// so that tests can produce deterministic IDs. The zero value is ready to use and is safe for concurrent use.
+type IDGenerator struct {
+	Rand      *rand.Rand       // the generator used for the random bits, nil uses a generator backed by SecureSource
+	Now       func() time.Time // the clock used for UUIDv7 and ULID, nil uses time.Now
+	Monotonic bool             // makes the ULIDs made within the same millisecond increase by incrementing the random bits
+
+	mu       sync.Mutex
+	lastULID ULID
+}
+
+// defaultIDGenerator is the generator used by NewUUIDv4, NewUUIDv7, NewULID and NanoID.
+var defaultIDGenerator = &IDGenerator{Rand: NewSecureRand(), Monotonic: true}
+
+// UUIDv4 function returns a new random (version 4) UUID.
+func (g *IDGenerator) UUIDv4() UUID {
+	var u UUID
+	g.mu.Lock()
+	g.read(u[:])
+	g.mu.Unlock()
+	u[6] = u[6]&0x0f | 0x40
+	u[8] = u[8]&0x3f | 0x80
+	return u
+}
+
+// UUIDv7 function returns a new time-ordered (version 7) UUID, starting with the unix time in milliseconds.
+func (g *IDGenerator) UUIDv7() UUID {
+	var u UUID
+	g.mu.Lock()
+	ms := g.now()
+	g.read(u[6:])
+	g.mu.Unlock()
+	putMillis(u[:6], ms)
+	u[6] = u[6]&0x0f | 0x70
+	u[8] = u[8]&0x3f | 0x80
+	return u
+}
+
+// ULID function returns a new ULID.
+// In Monotonic mode a ULID made within the same millisecond as the previous one gets its random bits incremented by one,
+// and if they overflow the timestamp is moved one millisecond ahead, so ULIDs are always increasing.
+func (g *IDGenerator) ULID() ULID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	var u ULID
+	ms := g.now()
+	if g.Monotonic && g.lastULID != (ULID{}) && ms <= g.lastULID.millis() {
+		u = g.lastULID
+		i := len(u) - 1
+		for ; i >= 6; i-- {
+			u[i]++
+			if u[i] != 0 {
+				break
+			}
+		}
+		if i < 6 {
+			ms = g.lastULID.millis() + 1
+			g.read(u[6:])
+			putMillis(u[:6], ms)
+		}
+	} else {
+		putMillis(u[:6], ms)
+		g.read(u[6:])
+	}
+	g.lastULID = u
+	return u
+}
+
+// NanoID function returns a new NanoID of size runes drawn from alphabet.
+// size 0 uses DefaultNanoIDSize and an empty alphabet uses AlphabetURLSafe, like the reference implementation.
+// It returns the generated ID and any write error encountered.
+func (g *IDGenerator) NanoID(size int, alphabet Alphabet) (string, error) {
+	const fn = "NanoID"
+	if size == 0 {
+		size = DefaultNanoIDSize
+	}
+	if len(alphabet) == 0 {
+		alphabet = AlphabetURLSafe
+	}
+	if size < 0 {
+		return "", &Error{fn, fmt.Errorf("%w: size must not be negative", ErrInvalid)}
+	}
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	s, _ := NewUniformString(size, size, alphabet)
+	s.Rand = g.generator()
+	return s.Sample(), nil
+}
+
+// generator is one of the inner functions of this package.
+// It returns the generator of g, setting up a secure one if there is none. g.mu must be held.
+func (g *IDGenerator) generator() *rand.Rand {
+	if g.Rand == nil {
+		g.Rand = NewSecureRand()
+	}
+	return g.Rand
+}
+
+// read is one of the inner functions of this package.
+// It fills b with random bytes. g.mu must be held.
+func (g *IDGenerator) read(b []byte) {
+	g.generator().Read(b)
+}
+
+// now is one of the inner functions of this package.
+// It returns the current unix time in milliseconds.
+func (g *IDGenerator) now() int64 {
+	if g.Now == nil {
+		return time.Now().UnixMilli()
+	}
+	return g.Now().UnixMilli()
+}
+
+// NewUUIDv4 function returns a new random (version 4) UUID made with a secure generator.
+// example: random.NewUUIDv4().String(), returns a string like "9b2e1a4c-7f3d-4e8a-b1c2-5d6e7f8a9b0c".
+func NewUUIDv4() UUID {
+	return defaultIDGenerator.UUIDv4()
+}
+
+// NewUUIDv7 function returns a new time-ordered (version 7) UUID made with a secure generator.
+func NewUUIDv7() UUID {
+	return defaultIDGenerator.UUIDv7()
+}
+
+// NewULID function returns a new monotonic ULID made with a secure generator.
+// example: random.NewULID().String(), returns a string like "01JAB3K7QZ8X4N2M5P6R7S8T9V".
+func NewULID() ULID {
+	return defaultIDGenerator.ULID()
+}
+
+// NanoID function returns a new NanoID of size runes drawn from alphabet with a secure generator.
+// size 0 uses DefaultNanoIDSize and an empty alphabet uses AlphabetURLSafe.
+// It returns the generated ID and any write error encountered.
+// example: random.NanoID(0, nil), returns a string like "V1StGXR8_Z5jdHi6B-myT".
+func NanoID(size int, alphabet Alphabet) (string, error) {
+	return defaultIDGenerator.NanoID(size, alphabet)
+}
+
+// String function returns the UUID in its canonical form, like "f81d4fae-7dec-11d0-a765-00a0c91e6bf6".
+func (u UUID) String() string {
+	var b [36]byte
+	hex.Encode(b[0:8], u[0:4])
+	b[8] = '-'
+	hex.Encode(b[9:13], u[4:6])
+	b[13] = '-'
+	hex.Encode(b[14:18], u[6:8])
+	b[18] = '-'
+	hex.Encode(b[19:23], u[8:10])
+	b[23] = '-'
+	hex.Encode(b[24:], u[10:])
+	return string(b[:])
+}
+
+// Version function returns the version of the UUID, 4 for NewUUIDv4 and 7 for NewUUIDv7.
+func (u UUID) Version() int {
+	return int(u[6] >> 4)
+}
+
+// Time function returns the time stored in a version 7 UUID, with a precision of one millisecond.
+func (u UUID) Time() time.Time {
+	return time.UnixMilli(u.millis())
+}
+
+// MarshalText implements encoding.TextMarshaler, it returns the canonical form of the UUID.
+func (u UUID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it accepts the forms ParseUUID does.
+func (u *UUID) UnmarshalText(b []byte) error {
+	p, err := ParseUUID(string(b))
+	if err != nil {
+		return err
+	}
+	*u = p
+	return nil
+}
+
+// ParseUUID function is used to parse a UUID in its canonical form, without dashes,
+// in braces like "{f81d4fae-7dec-11d0-a765-00a0c91e6bf6}" or as a URN like "urn:uuid:f81d4fae-7dec-11d0-a765-00a0c91e6bf6".
+// It returns the parsed UUID and any write error encountered.
+func ParseUUID(s string) (UUID, error) {
+	const fn = "ParseUUID"
+	var u UUID
+	switch {
+	case len(s) == 45 && strings.EqualFold(s[:9], "urn:uuid:"):
+		s = s[9:]
+	case len(s) == 38 && s[0] == '{' && s[37] == '}':
+		s = s[1:37]
+	}
+	if len(s) == 36 {
+		if s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+			return u, &Error{fn, fmt.Errorf("%w: malformed UUID %q", ErrInvalid, s)}
+		}
+		s = s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	}
+	if len(s) != 32 {
+		return u, &Error{fn, fmt.Errorf("%w: malformed UUID %q", ErrInvalid, s)}
+	}
+	if _, err := hex.Decode(u[:], []byte(s)); err != nil {
+		return u, &Error{fn, fmt.Errorf("%w: malformed UUID %q", ErrInvalid, s)}
+	}
+	return u, nil
+}
+
+// String function returns the ULID in its canonical form of 26 Crockford's base32 characters.
+func (u ULID) String() string {
+	var b [26]byte
+	hi, lo := uint64(0), uint64(0)
+	for i := 0; i < 8; i++ {
+		hi = hi<<8 | uint64(u[i])
+		lo = lo<<8 | uint64(u[i+8])
+	}
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i] = byte(AlphabetCrockford[lo&31])
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(b[:])
+}
+
+// Time function returns the time stored in the ULID, with a precision of one millisecond.
+func (u ULID) Time() time.Time {
+	return time.UnixMilli(u.millis())
+}
+
+// MarshalText implements encoding.TextMarshaler, it returns the canonical form of the ULID.
+func (u ULID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, it accepts the forms ParseULID does.
+func (u *ULID) UnmarshalText(b []byte) error {
+	p, err := ParseULID(string(b))
+	if err != nil {
+		return err
+	}
+	*u = p
+	return nil
+}
+
+// ParseULID function is used to parse a ULID of 26 Crockford's base32 characters.
+// Parsing is case-insensitive and accepts I and L for 1 and O for 0, as Crockford's base32 does.
+// It returns the parsed ULID and any write error encountered.
+func ParseULID(s string) (ULID, error) {
+	const fn = "ParseULID"
+	var u ULID
+	if len(s) != 26 {
+		return u, &Error{fn, fmt.Errorf("%w: a ULID must have 26 characters", ErrInvalid)}
+	}
+	hi, lo := uint64(0), uint64(0)
+	for i := 0; i < len(s); i++ {
+		v := crockfordValue(s[i])
+		if v < 0 || (i == 0 && v > 7) {
+			return u, &Error{fn, fmt.Errorf("%w: malformed ULID %q", ErrInvalid, s)}
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(v)
+	}
+	for i := 7; i >= 0; i-- {
+		u[i] = byte(hi)
+		u[i+8] = byte(lo)
+		hi >>= 8
+		lo >>= 8
+	}
+	return u, nil
+}
+
+// crockfordValue is one of the inner functions of this package.
+// It returns the value of a Crockford's base32 character, or -1 if it is not one.
+func crockfordValue(c byte) int {
+	switch c {
+	case 'o', 'O':
+		return 0
+	case 'i', 'I', 'l', 'L':
+		return 1
+	}
+	if c >= 'a' && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	for v, d := range AlphabetCrockford {
+		if rune(c) == d {
+			return v
+		}
+	}
+	return -1
+}
+
+// putMillis is one of the inner functions of this package.
+// It writes the lower 48 bits of ms to b in big endian order.
+func putMillis(b []byte, ms int64) {
+	for i := 5; i >= 0; i-- {
+		b[i] = byte(ms)
+		ms >>= 8
+	}
+}
+
+// millis is one of the inner functions of this package.
+// It returns the 48 bit timestamp at the start of the ID.
+func (u UUID) millis() int64 {
+	return int64(u[0])<<40 | int64(u[1])<<32 | int64(u[2])<<24 | int64(u[3])<<16 | int64(u[4])<<8 | int64(u[5])
+}
+
+// millis is one of the inner functions of this package.
+// It returns the 48 bit timestamp at the start of the ID.
+func (u ULID) millis() int64 {
+	return UUID(u).millis()
+}
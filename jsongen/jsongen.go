@@ -0,0 +1,150 @@
+/*
+ * File: jsongen.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+// Package jsongen generates random JSON documents for API fuzzing: arbitrary documents within limits of depth,
+// width and size, and documents which conform to a subset of JSON Schema.
+// Values are built from the types of encoding/json, like map[string]interface{} and float64, and every
+// Generator with a seeded generator produces the same documents every time.
+package jsongen
+
+import (
+	"encoding/json"
+	"math/rand"
+	"strconv"
+
+	"github.com/anonyindian/random-go"
+)
+
+// The default limits of a Generator.
+const (
+	DefaultMaxDepth     = 4
+	DefaultMaxWidth     = 5
+	DefaultMaxValues    = 100
+	DefaultMaxStringLen = 16
+)
+
+// Generator generates random JSON values. The zero value uses the default limits.
+// It is not safe for concurrent use when it has a Rand, as *rand.Rand isn't.
+type Generator struct {
+	MaxDepth     int        // the deepest nesting of arrays and objects, DefaultMaxDepth if it is 0
+	MaxWidth     int        // the most elements of an array or properties of an object, DefaultMaxWidth if it is 0
+	MaxValues    int        // the most values in a document, counting every nested value, DefaultMaxValues if it is 0
+	MaxStringLen int        // the longest strings and property names in runes, DefaultMaxStringLen if it is 0
+	Rand         *rand.Rand // the generator used, nil uses random.Default()
+}
+
+// Value function returns a random JSON value of any type: null, a bool, a number, a string, an array or an object.
+// Numbers are float64, arrays are []interface{} and objects are map[string]interface{}, like encoding/json decodes them.
+// example: g.Value(), returns a value like map[string]interface{}{"x3Fa": []interface{}{1.5, nil, "q"}}.
+func (g *Generator) Value() interface{} {
+	budget := g.limit(g.MaxValues, DefaultMaxValues)
+	return g.value(0, &budget)
+}
+
+// JSON function returns a random JSON document, the encoding of Value.
+// example: g.JSON(), returns a document like []byte(`{"x3Fa":[1.5,null,"q"]}`).
+func (g *Generator) JSON() []byte {
+	b, _ := json.Marshal(g.Value())
+	return b
+}
+
+// value is one of the inner functions of this package.
+// It returns a random value at the given depth, using up budget, the number of values left.
+func (g *Generator) value(depth int, budget *int) interface{} {
+	*budget--
+	r := g.rand()
+	kinds := 6
+	if depth >= g.limit(g.MaxDepth, DefaultMaxDepth) || *budget <= 0 {
+		// Only scalars are left when the limits are reached.
+		kinds = 4
+	}
+	switch r.Intn(kinds) {
+	case 0:
+		return nil
+	case 1:
+		return r.Intn(2) == 1
+	case 2:
+		if r.Intn(2) == 0 {
+			return float64(r.Intn(2001) - 1000)
+		}
+		return r.NormFloat64() * 1000
+	case 3:
+		return g.string(0, g.limit(g.MaxStringLen, DefaultMaxStringLen))
+	case 4:
+		a := make([]interface{}, g.width(budget))
+		for i := range a {
+			a[i] = g.value(depth+1, budget)
+		}
+		return a
+	}
+	n := g.width(budget)
+	m := make(map[string]interface{}, n)
+	for i := 0; i < n; i++ {
+		m[g.string(1, g.limit(g.MaxStringLen, DefaultMaxStringLen))] = g.value(depth+1, budget)
+	}
+	return m
+}
+
+// width is one of the inner functions of this package.
+// It returns a random number of elements for an array or object, which doesn't exceed the budget.
+func (g *Generator) width(budget *int) int {
+	n := g.rand().Intn(g.limit(g.MaxWidth, DefaultMaxWidth) + 1)
+	if n > *budget {
+		n = *budget
+	}
+	return n
+}
+
+// string is one of the inner functions of this package.
+// It returns a random alphanumeric string of minLength to maxLength runes.
+func (g *Generator) string(minLength int, maxLength int) string {
+	s := random.UniformString{Alphabet: random.AlphabetAlnum, MinLength: minLength, MaxLength: maxLength, Rand: g.rand()}
+	return s.Sample()
+}
+
+// rand is one of the inner functions of this package.
+// It returns the generator of g.
+func (g *Generator) rand() *rand.Rand {
+	if g.Rand == nil {
+		return random.Default()
+	}
+	return g.Rand
+}
+
+// limit is one of the inner functions of this package.
+// It returns v, or def if v isn't set.
+func (g *Generator) limit(v int, def int) int {
+	if v <= 0 {
+		return def
+	}
+	return v
+}
+
+// key is one of the inner functions of this package.
+// It returns a string which is equal for equal JSON values, to compare them.
+func key(v interface{}) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return strconv.Quote(err.Error())
+	}
+	return string(b)
+}
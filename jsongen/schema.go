@@ -0,0 +1,400 @@
+/*
+ * File: schema.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package jsongen
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/netip"
+	"sort"
+	"time"
+
+	"github.com/anonyindian/random-go"
+)
+
+// maxTries is the number of attempts made to draw a value which meets constraints checked after drawing,
+// like the length of a string matching a pattern or the uniqueness of array items.
+const maxTries = 100
+
+// Schema is the subset of JSON Schema which Conform supports: types, enums, numeric bounds, string lengths,
+// patterns and formats, arrays and objects with required properties. Other keywords are ignored.
+type Schema struct {
+	Type             Types              `json:"type,omitempty"`
+	Enum             []interface{}      `json:"enum,omitempty"`
+	Minimum          *float64           `json:"minimum,omitempty"`
+	Maximum          *float64           `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64           `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64           `json:"exclusiveMaximum,omitempty"`
+	MultipleOf       *float64           `json:"multipleOf,omitempty"`
+	MinLength        *int               `json:"minLength,omitempty"`
+	MaxLength        *int               `json:"maxLength,omitempty"`
+	Pattern          string             `json:"pattern,omitempty"`
+	Format           string             `json:"format,omitempty"` // date-time, date, email, uuid, ipv4 or ipv6
+	Items            *Schema            `json:"items,omitempty"`
+	MinItems         *int               `json:"minItems,omitempty"`
+	MaxItems         *int               `json:"maxItems,omitempty"`
+	UniqueItems      bool               `json:"uniqueItems,omitempty"`
+	Properties       map[string]*Schema `json:"properties,omitempty"`
+	Required         []string           `json:"required,omitempty"`
+	AnyOf            []*Schema          `json:"anyOf,omitempty"`
+	OneOf            []*Schema          `json:"oneOf,omitempty"`
+}
+
+// Types is the "type" keyword of a Schema, JSON Schema allows a single type or a list of types.
+type Types []string
+
+// UnmarshalJSON decodes a single type like "string" or a list like ["string", "null"].
+func (t *Types) UnmarshalJSON(b []byte) error {
+	var one string
+	if err := json.Unmarshal(b, &one); err == nil {
+		*t = Types{one}
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(b, &list); err != nil {
+		return err
+	}
+	*t = list
+	return nil
+}
+
+// ParseSchema function is used to decode a JSON Schema.
+// It returns the schema of type *Schema and any write error encountered.
+// example: jsongen.ParseSchema([]byte(`{"type": "integer", "minimum": 1, "maximum": 6}`)), returns the schema of a dice roll.
+func ParseSchema(data []byte) (*Schema, error) {
+	s := new(Schema)
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, &random.Error{Func: "ParseSchema", Err: fmt.Errorf("%w: %v", random.ErrInvalid, err)}
+	}
+	return s, nil
+}
+
+// Conform function returns a random JSON value which is valid against s.
+// Optional properties are present with a probability of 1/2 and a subschema of anyOf or oneOf is chosen at random,
+// the other subschemas aren't checked against the value.
+// It returns the value and any write error encountered, like ErrInvalid for contradicting constraints.
+// example: g.Conform(schema), returns a value like map[string]interface{}{"id": 42.0, "tags": []interface{}{"a"}}.
+func (g *Generator) Conform(s *Schema) (interface{}, error) {
+	budget := g.limit(g.MaxValues, DefaultMaxValues)
+	v, err := g.conform(s, "#", &budget)
+	if err != nil {
+		return nil, &random.Error{Func: "Conform", Err: err}
+	}
+	return v, nil
+}
+
+// ConformJSON function returns a random JSON document which is valid against s, the encoding of Conform.
+// It returns the document and any write error encountered.
+func (g *Generator) ConformJSON(s *Schema) ([]byte, error) {
+	v, err := g.Conform(s)
+	if err != nil {
+		return nil, err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, &random.Error{Func: "ConformJSON", Err: err}
+	}
+	return b, nil
+}
+
+// conform is one of the inner functions of this package.
+// It returns a random value valid against s, path locates s in errors and budget is the number of values left.
+func (g *Generator) conform(s *Schema, path string, budget *int) (interface{}, error) {
+	r := g.rand()
+	if s == nil {
+		return g.value(0, budget), nil
+	}
+	if len(s.Enum) > 0 {
+		*budget--
+		return s.Enum[r.Intn(len(s.Enum))], nil
+	}
+	if subs := append(append([]*Schema(nil), s.AnyOf...), s.OneOf...); len(subs) > 0 {
+		i := r.Intn(len(subs))
+		sub := fmt.Sprintf("%s/anyOf/%d", path, i)
+		if i >= len(s.AnyOf) {
+			sub = fmt.Sprintf("%s/oneOf/%d", path, i-len(s.AnyOf))
+		}
+		return g.conform(subs[i], sub, budget)
+	}
+	typ := ""
+	switch {
+	case len(s.Type) > 0:
+		typ = s.Type[r.Intn(len(s.Type))]
+	case s.Properties != nil || len(s.Required) > 0:
+		typ = "object"
+	case s.Items != nil:
+		typ = "array"
+	case s.Pattern != "" || s.Format != "" || s.MinLength != nil || s.MaxLength != nil:
+		typ = "string"
+	case s.Minimum != nil || s.Maximum != nil || s.ExclusiveMinimum != nil || s.ExclusiveMaximum != nil:
+		typ = "number"
+	default:
+		return g.value(0, budget), nil
+	}
+	*budget--
+	switch typ {
+	case "null":
+		return nil, nil
+	case "boolean":
+		return r.Intn(2) == 1, nil
+	case "integer", "number":
+		return g.number(s, typ == "integer", path)
+	case "string":
+		return g.conformString(s, path)
+	case "array":
+		return g.array(s, path, budget)
+	case "object":
+		return g.object(s, path, budget)
+	}
+	return nil, fmt.Errorf("%s: %w: type %q", path, random.ErrUnsupported, typ)
+}
+
+// number is one of the inner functions of this package.
+// It returns a random number within the bounds of s, an integer if integer is set.
+func (g *Generator) number(s *Schema, integer bool, path string) (interface{}, error) {
+	r := g.rand()
+	lo, hi := math.Inf(-1), math.Inf(1)
+	loOpen, hiOpen := false, false
+	if s.Minimum != nil {
+		lo = *s.Minimum
+	}
+	if s.ExclusiveMinimum != nil && *s.ExclusiveMinimum >= lo {
+		lo, loOpen = *s.ExclusiveMinimum, true
+	}
+	if s.Maximum != nil {
+		hi = *s.Maximum
+	}
+	if s.ExclusiveMaximum != nil && *s.ExclusiveMaximum <= hi {
+		hi, hiOpen = *s.ExclusiveMaximum, true
+	}
+	// Unbounded sides get a range of 1000 around the other bound, or around 0.
+	switch {
+	case math.IsInf(lo, -1) && math.IsInf(hi, 1):
+		lo, hi = -1000, 1000
+	case math.IsInf(lo, -1):
+		lo = math.Min(hi, 0) - 1000
+	case math.IsInf(hi, 1):
+		hi = math.Max(lo, 0) + 1000
+	}
+	if hi < lo || (hi == lo && (loOpen || hiOpen)) {
+		return nil, fmt.Errorf("%s: %w: empty range of numbers", path, random.ErrInvalid)
+	}
+	step := 0.0
+	if integer {
+		step = 1
+	}
+	if s.MultipleOf != nil {
+		if *s.MultipleOf <= 0 {
+			return nil, fmt.Errorf("%s: %w: multipleOf must be positive", path, random.ErrInvalid)
+		}
+		step = *s.MultipleOf
+		if integer && step != math.Trunc(step) {
+			// An integer multiple of a fraction, like 2.5, is a multiple of the smallest integer multiple of it.
+			m := step
+			for i := 2; m != math.Trunc(m) && i <= 1000; i++ {
+				m = step * float64(i)
+			}
+			step = m
+		}
+	}
+	if step == 0 {
+		for try := 0; try < maxTries; try++ {
+			x := lo + r.Float64()*(hi-lo)
+			if (x > lo || !loOpen) && (x < hi || !hiOpen) {
+				return x, nil
+			}
+		}
+		return nil, fmt.Errorf("%s: %w: empty range of numbers", path, random.ErrInvalid)
+	}
+	first, last := math.Ceil(lo/step), math.Floor(hi/step)
+	if loOpen && first*step <= lo {
+		first++
+	}
+	if hiOpen && last*step >= hi {
+		last--
+	}
+	if first > last {
+		return nil, fmt.Errorf("%s: %w: no number fits the bounds", path, random.ErrInvalid)
+	}
+	return (first + math.Floor(r.Float64()*(last-first+1))) * step, nil
+}
+
+// conformString is one of the inner functions of this package.
+// It returns a random string meeting the format, pattern and length constraints of s.
+func (g *Generator) conformString(s *Schema, path string) (interface{}, error) {
+	r := g.rand()
+	// The default bounds only apply to plain strings, formats and patterns are only bounded by the schema.
+	minLength, maxLength := 0, math.MaxInt32
+	if s.MinLength != nil {
+		minLength = *s.MinLength
+	}
+	if s.MaxLength != nil {
+		maxLength = *s.MaxLength
+	}
+	if minLength < 0 || minLength > maxLength {
+		return nil, fmt.Errorf("%s: %w: need 0 <= minLength <= maxLength", path, random.ErrInvalid)
+	}
+	var next func() string
+	switch {
+	case s.Format != "":
+		var err error
+		if next, err = g.format(s.Format); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+	case s.Pattern != "":
+		re, err := random.NewRegexString(s.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		re.Rand = r
+		next = re.Sample
+	default:
+		if s.MaxLength == nil {
+			maxLength = minLength + g.limit(g.MaxStringLen, DefaultMaxStringLen)
+			if s.MinLength == nil {
+				maxLength = g.limit(g.MaxStringLen, DefaultMaxStringLen)
+			}
+		}
+		return (&random.UniformString{Alphabet: random.AlphabetAlnum, MinLength: minLength, MaxLength: maxLength, Rand: r}).Sample(), nil
+	}
+	for try := 0; try < maxTries; try++ {
+		if x := next(); len([]rune(x)) >= minLength && len([]rune(x)) <= maxLength {
+			return x, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: %w: no string of the format or pattern fits the length bounds", path, random.ErrInvalid)
+}
+
+// format is one of the inner functions of this package.
+// It returns a function drawing strings of the given format.
+func (g *Generator) format(format string) (func() string, error) {
+	r := g.rand()
+	switch format {
+	case "date-time", "date":
+		start := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+		layout := time.RFC3339
+		if format == "date" {
+			layout = "2006-01-02"
+		}
+		return func() string {
+			t, _ := random.Time(start, start.AddDate(50, 0, 0), r)
+			return t.Truncate(time.Second).Format(layout)
+		}, nil
+	case "email":
+		re, _ := random.NewRegexString(`[a-z]{1,10}(\.[a-z]{1,10})?@example\.(com|org|net)`)
+		re.Rand = r
+		return re.Sample, nil
+	case "uuid":
+		ids := &random.IDGenerator{Rand: r}
+		return func() string {
+			return ids.UUIDv4().String()
+		}, nil
+	case "ipv4", "ipv6":
+		all := netip.MustParsePrefix("0.0.0.0/0")
+		if format == "ipv6" {
+			all = netip.MustParsePrefix("::/0")
+		}
+		return func() string {
+			a, _ := random.IPInPrefix(all, r)
+			return a.String()
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: format %q", random.ErrUnsupported, format)
+}
+
+// array is one of the inner functions of this package.
+// It returns a random array meeting the item and size constraints of s.
+func (g *Generator) array(s *Schema, path string, budget *int) (interface{}, error) {
+	minItems, maxItems := 0, g.limit(g.MaxWidth, DefaultMaxWidth)
+	if s.MinItems != nil {
+		minItems = *s.MinItems
+		if maxItems < minItems {
+			maxItems = minItems
+		}
+	}
+	if s.MaxItems != nil {
+		maxItems = *s.MaxItems
+	}
+	if minItems < 0 || minItems > maxItems {
+		return nil, fmt.Errorf("%s: %w: need 0 <= minItems <= maxItems", path, random.ErrInvalid)
+	}
+	n := minItems + g.rand().Intn(maxItems-minItems+1)
+	if n > minItems && n > *budget {
+		n = minItems
+		if *budget > n {
+			n = *budget
+		}
+	}
+	a := make([]interface{}, 0, n)
+	seen := make(map[string]bool)
+	for tries := 0; len(a) < n; tries++ {
+		if tries >= n*maxTries {
+			return nil, fmt.Errorf("%s: %w: not enough unique items", path, random.ErrInvalid)
+		}
+		v, err := g.conform(s.Items, fmt.Sprintf("%s/items", path), budget)
+		if err != nil {
+			return nil, err
+		}
+		if s.UniqueItems {
+			if seen[key(v)] {
+				continue
+			}
+			seen[key(v)] = true
+		}
+		a = append(a, v)
+	}
+	return a, nil
+}
+
+// object is one of the inner functions of this package.
+// It returns a random object with the required properties of s and some of the optional ones.
+func (g *Generator) object(s *Schema, path string, budget *int) (interface{}, error) {
+	r := g.rand()
+	required := make(map[string]bool, len(s.Required))
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	// The properties are visited in order, so that a seed always gives the same object.
+	names := make([]string, 0, len(s.Properties)+len(s.Required))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	for _, name := range s.Required {
+		if _, ok := s.Properties[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	m := make(map[string]interface{}, len(names))
+	for _, name := range names {
+		if !required[name] && (*budget <= 0 || r.Intn(2) == 0) {
+			continue
+		}
+		v, err := g.conform(s.Properties[name], path+"/properties/"+name, budget)
+		if err != nil {
+			return nil, err
+		}
+		m[name] = v
+	}
+	return m, nil
+}
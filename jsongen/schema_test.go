@@ -0,0 +1,78 @@
+/*
+ * File: schema_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package jsongen_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/anonyindian/random-go"
+	"github.com/anonyindian/random-go/jsongen"
+)
+
+func TestConformNumber(t *testing.T) {
+	g := &jsongen.Generator{Rand: random.NewRand(1)}
+	for _, c := range []struct {
+		schema string
+		lo, hi float64
+	}{
+		{`{"type": "number", "minimum": 2, "maximum": 3}`, 2, 3},
+		{`{"type": "number", "minimum": 2, "maximum": 2}`, 2, 2},
+		{`{"type": "number", "exclusiveMinimum": -1, "exclusiveMaximum": 0}`, -1, 0},
+		{`{"type": "integer", "minimum": 1, "maximum": 6}`, 1, 6},
+		{`{"type": "number", "minimum": 5000}`, 5000, 6000},
+	} {
+		s, err := jsongen.ParseSchema([]byte(c.schema))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i < 100; i++ {
+			v, err := g.Conform(s)
+			if err != nil {
+				t.Fatalf("%s: %v", c.schema, err)
+			}
+			if x := v.(float64); x < c.lo || x > c.hi {
+				t.Fatalf("%s: got %v", c.schema, x)
+			}
+		}
+	}
+}
+
+func TestConformNumberEmptyRange(t *testing.T) {
+	g := &jsongen.Generator{Rand: random.NewRand(1)}
+	for _, schema := range []string{
+		`{"type": "number", "minimum": 3, "maximum": 2}`,
+		`{"type": "number", "minimum": 2, "exclusiveMaximum": 2}`,
+		`{"type": "number", "exclusiveMinimum": 2, "maximum": 2}`,
+		`{"type": "number", "maximum": -5000, "minimum": 0}`,
+		`{"type": "integer", "minimum": 3, "maximum": 2}`,
+		`{"type": "number", "multipleOf": 0.5, "minimum": 3, "maximum": 2}`,
+	} {
+		s, err := jsongen.ParseSchema([]byte(schema))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v, err := g.Conform(s); !errors.Is(err, random.ErrInvalid) {
+			t.Errorf("%s: got %v, %v, want ErrInvalid", schema, v, err)
+		}
+	}
+}
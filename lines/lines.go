@@ -0,0 +1,328 @@
+/*
+ * File: lines.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+// Package lines shuffles and samples the lines of inputs which don't fit in memory, like large log files.
+// Lines are read from an io.Reader and written to an io.Writer, lines which don't fit in the memory limit are
+// spilled to temporary files. Every written line ends with a newline, also the last line of an input without one.
+package lines
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+
+	"github.com/anonyindian/random-go"
+)
+
+// The defaults of Options.
+const (
+	DefaultMemoryLimit = 64 << 20 // 64 MiB
+	DefaultBuckets     = 64
+)
+
+// lineOverhead is the memory counted for every line held in memory on top of its bytes, for its slice header.
+const lineOverhead = 24
+
+// Options controls Shuffle and Sample. The zero value uses the defaults.
+type Options struct {
+	MemoryLimit int64      // the bytes of lines held in memory, DefaultMemoryLimit if it is 0
+	TempDir     string     // the directory of the temporary files, "" uses os.TempDir()
+	Buckets     int        // the number of temporary files a Shuffle spills to at once, DefaultBuckets if it is 0
+	Rand        *rand.Rand // the generator used, nil uses random.Default()
+}
+
+// withDefaults is one of the inner functions of this package.
+// It returns o with the defaults filled in.
+func (o Options) withDefaults() Options {
+	if o.MemoryLimit <= 0 {
+		o.MemoryLimit = DefaultMemoryLimit
+	}
+	if o.Buckets <= 1 {
+		o.Buckets = DefaultBuckets
+	}
+	if o.Rand == nil {
+		o.Rand = random.Default()
+	}
+	return o
+}
+
+// Shuffle function is used to write the lines of src to dst in uniformly random order.
+// Inputs which fit in the memory limit are shuffled in memory. Larger inputs are split by randomized bucketing:
+// every line goes to a random temporary file, then the files are shuffled one by one, split again if they are still
+// too large, and concatenated. Concatenating the buckets in place of a merge step is deliberate: a random split
+// followed by a shuffle of every part already gives a uniform permutation, so the result is as random as an
+// in-memory shuffle, with about two reads and writes of the input.
+// It returns any write error encountered.
+// example: lines.Shuffle(out, in, lines.Options{MemoryLimit: 1 << 30, TempDir: "/scratch"}), shuffles a huge file with 1 GiB of memory.
+func Shuffle(dst io.Writer, src io.Reader, o Options) error {
+	o = o.withDefaults()
+	w := bufio.NewWriter(dst)
+	if err := shuffle(w, bufio.NewReader(src), o); err != nil {
+		return &random.Error{Func: "Shuffle", Err: err}
+	}
+	if err := w.Flush(); err != nil {
+		return &random.Error{Func: "Shuffle", Err: err}
+	}
+	return nil
+}
+
+// shuffle is one of the inner functions of this package.
+// It writes the lines of r to w in random order, spilling to buckets when they don't fit in memory.
+func shuffle(w *bufio.Writer, r *bufio.Reader, o Options) error {
+	var mem [][]byte
+	size := int64(0)
+	spill := false
+	for {
+		line, err := readLine(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		mem = append(mem, line)
+		size += int64(len(line)) + lineOverhead
+		// A single line larger than the limit is written as it is, it can't be split anyway.
+		if size > o.MemoryLimit && len(mem) > 1 {
+			spill = true
+			break
+		}
+	}
+	if !spill {
+		o.Rand.Shuffle(len(mem), func(i, j int) {
+			mem[i], mem[j] = mem[j], mem[i]
+		})
+		for _, line := range mem {
+			if err := writeLine(w, line); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	buckets := make([]*os.File, o.Buckets)
+	writers := make([]*bufio.Writer, o.Buckets)
+	defer func() {
+		for _, f := range buckets {
+			if f != nil {
+				f.Close()
+				os.Remove(f.Name())
+			}
+		}
+	}()
+	for i := range buckets {
+		f, err := os.CreateTemp(o.TempDir, "random-lines-*")
+		if err != nil {
+			return err
+		}
+		buckets[i] = f
+		writers[i] = bufio.NewWriter(f)
+	}
+	for _, line := range mem {
+		if err := writeLine(writers[o.Rand.Intn(len(writers))], line); err != nil {
+			return err
+		}
+	}
+	mem = nil
+	for {
+		line, err := readLine(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		if err := writeLine(writers[o.Rand.Intn(len(writers))], line); err != nil {
+			return err
+		}
+	}
+	for i, f := range buckets {
+		if err := writers[i].Flush(); err != nil {
+			return err
+		}
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return err
+		}
+		if err := shuffle(w, bufio.NewReader(f), o); err != nil {
+			return err
+		}
+		// Release the disk space of a bucket as soon as it is done.
+		f.Close()
+		os.Remove(f.Name())
+		buckets[i] = nil
+	}
+	return nil
+}
+
+// Sample function is used to write k lines of src, drawn uniformly without replacement, to dst in random order.
+// Inputs of less than k lines are written whole, in random order. The input is read once with reservoir sampling,
+// the reservoir is held in memory until it exceeds the memory limit, then its lines are kept in a temporary file
+// and only their positions stay in memory.
+// It returns any write error encountered.
+// example: lines.Sample(out, in, 1000, lines.Options{}), writes 1000 random lines of in.
+func Sample(dst io.Writer, src io.Reader, k int, o Options) error {
+	const fn = "Sample"
+	if k < 0 {
+		return &random.Error{Func: fn, Err: fmt.Errorf("%w: k must not be negative", random.ErrInvalid)}
+	}
+	o = o.withDefaults()
+	res := &reservoir{limit: o.MemoryLimit, dir: o.TempDir}
+	defer res.close()
+	r := bufio.NewReader(src)
+	for n := 0; ; n++ {
+		line, err := readLine(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return &random.Error{Func: fn, Err: err}
+		}
+		// Algorithm R: line n replaces a random slot with a probability of k / (n + 1).
+		slot := n
+		if n >= k {
+			if slot = o.Rand.Intn(n + 1); slot >= k {
+				continue
+			}
+		}
+		if err := res.set(slot, line); err != nil {
+			return &random.Error{Func: fn, Err: err}
+		}
+	}
+	w := bufio.NewWriter(dst)
+	for _, slot := range o.Rand.Perm(res.len()) {
+		line, err := res.get(slot)
+		if err != nil {
+			return &random.Error{Func: fn, Err: err}
+		}
+		if err := writeLine(w, line); err != nil {
+			return &random.Error{Func: fn, Err: err}
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return &random.Error{Func: fn, Err: err}
+	}
+	return nil
+}
+
+// reservoir holds the sampled lines, in memory or, past its limit, in a temporary file.
+type reservoir struct {
+	limit int64
+	dir   string
+
+	mem  [][]byte // the lines while in memory
+	size int64    // the memory used by mem
+	file *os.File // the file of the lines once spilled
+	end  int64    // the size of file
+	pos  [][2]int64
+}
+
+// len is one of the inner functions of this package.
+// It returns the number of lines held.
+func (s *reservoir) len() int {
+	if s.file != nil {
+		return len(s.pos)
+	}
+	return len(s.mem)
+}
+
+// set is one of the inner functions of this package.
+// It stores line in slot, which is an existing slot or the next one.
+func (s *reservoir) set(slot int, line []byte) error {
+	if s.file == nil {
+		if slot < len(s.mem) {
+			s.size -= int64(len(s.mem[slot]))
+			s.mem[slot] = line
+		} else {
+			s.mem = append(s.mem, line)
+			s.size += lineOverhead
+		}
+		s.size += int64(len(line))
+		if s.size <= s.limit {
+			return nil
+		}
+		// Move the lines to a file, keeping only their positions.
+		f, err := os.CreateTemp(s.dir, "random-lines-*")
+		if err != nil {
+			return err
+		}
+		s.file = f
+		mem := s.mem
+		s.mem = nil
+		for i, l := range mem {
+			if err := s.set(i, l); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	// Replaced lines are left in the file, which grows by about k * ln(n / k) lines in total.
+	if _, err := s.file.WriteAt(line, s.end); err != nil {
+		return err
+	}
+	p := [2]int64{s.end, int64(len(line))}
+	s.end += int64(len(line))
+	if slot < len(s.pos) {
+		s.pos[slot] = p
+	} else {
+		s.pos = append(s.pos, p)
+	}
+	return nil
+}
+
+// get is one of the inner functions of this package.
+// It returns the line in slot.
+func (s *reservoir) get(slot int) ([]byte, error) {
+	if s.file == nil {
+		return s.mem[slot], nil
+	}
+	line := make([]byte, s.pos[slot][1])
+	_, err := s.file.ReadAt(line, s.pos[slot][0])
+	return line, err
+}
+
+// close is one of the inner functions of this package.
+// It removes the file of the reservoir, if any.
+func (s *reservoir) close() {
+	if s.file != nil {
+		s.file.Close()
+		os.Remove(s.file.Name())
+	}
+}
+
+// readLine is one of the inner functions of this package.
+// It returns the next line of r without its newline, or io.EOF once all the lines were read.
+func readLine(r *bufio.Reader) ([]byte, error) {
+	line, err := r.ReadBytes('\n')
+	if err == io.EOF && len(line) > 0 {
+		err = nil
+	}
+	return bytes.TrimSuffix(line, []byte{'\n'}), err
+}
+
+// writeLine is one of the inner functions of this package.
+// It writes line and a newline to w.
+func writeLine(w *bufio.Writer, line []byte) error {
+	w.Write(line)
+	return w.WriteByte('\n')
+}
@@ -0,0 +1,134 @@
+/*
+ * File: lines_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package lines_test
+
+import (
+	"bytes"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/anonyindian/random-go"
+	"github.com/anonyindian/random-go/lines"
+)
+
+// input returns n numbered lines.
+func input(n int) []string {
+	in := make([]string, n)
+	for i := range in {
+		in[i] = "line " + strconv.Itoa(i)
+	}
+	return in
+}
+
+// split returns the lines of out, which must end with a newline.
+func split(t *testing.T, out string) []string {
+	t.Helper()
+	if !strings.HasSuffix(out, "\n") {
+		t.Fatalf("the output %q doesn't end with a newline", out)
+	}
+	return strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+}
+
+// checkEmpty fails if dir holds any file.
+func checkEmpty(t *testing.T, dir string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("%d temporary files left in %s", len(entries), dir)
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	for _, limit := range []int64{0, 300} {
+		dir := t.TempDir()
+		in := input(2000)
+		var out bytes.Buffer
+		o := lines.Options{MemoryLimit: limit, TempDir: dir, Buckets: 4, Rand: random.NewRand(1)}
+		if err := lines.Shuffle(&out, strings.NewReader(strings.Join(in, "\n")), o); err != nil {
+			t.Fatal(err)
+		}
+		got := split(t, out.String())
+		if len(got) != len(in) {
+			t.Fatalf("limit %d: %d lines, want %d", limit, len(got), len(in))
+		}
+		moved := 0
+		for i := range got {
+			if got[i] != in[i] {
+				moved++
+			}
+		}
+		if moved < len(in)/2 {
+			t.Errorf("limit %d: only %d of %d lines moved", limit, moved, len(in))
+		}
+		sort.Strings(got)
+		sort.Strings(in)
+		for i := range got {
+			if got[i] != in[i] {
+				t.Fatalf("limit %d: the output isn't a permutation of the input, %q != %q", limit, got[i], in[i])
+			}
+		}
+		checkEmpty(t, dir)
+	}
+}
+
+func TestSample(t *testing.T) {
+	for _, limit := range []int64{0, 300} {
+		dir := t.TempDir()
+		in := input(2000)
+		var out bytes.Buffer
+		o := lines.Options{MemoryLimit: limit, TempDir: dir, Rand: random.NewRand(1)}
+		if err := lines.Sample(&out, strings.NewReader(strings.Join(in, "\n")), 100, o); err != nil {
+			t.Fatal(err)
+		}
+		got := split(t, out.String())
+		if len(got) != 100 {
+			t.Fatalf("limit %d: %d lines, want 100", limit, len(got))
+		}
+		seen := make(map[string]bool)
+		for _, line := range got {
+			n, err := strconv.Atoi(strings.TrimPrefix(line, "line "))
+			if err != nil || n < 0 || n >= len(in) || seen[line] {
+				t.Fatalf("limit %d: unexpected or repeated line %q", limit, line)
+			}
+			seen[line] = true
+		}
+		checkEmpty(t, dir)
+	}
+}
+
+func TestSampleShortInput(t *testing.T) {
+	var out bytes.Buffer
+	if err := lines.Sample(&out, strings.NewReader("a\nb\nc"), 10, lines.Options{Rand: random.NewRand(1)}); err != nil {
+		t.Fatal(err)
+	}
+	got := split(t, out.String())
+	sort.Strings(got)
+	if strings.Join(got, ",") != "a,b,c" {
+		t.Errorf("got %q, want the whole input", got)
+	}
+}
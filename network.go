@@ -0,0 +1,319 @@
+/*
+ * File: network.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+	"net"
+	"net/netip"
+)
+
+// The range of the dynamic, or ephemeral, ports set aside by IANA for short-lived client connections.
+const (
+	EphemeralPortStart = 49152
+	EphemeralPortEnd   = 65535
+)
+
+// Address blocks which PublicIPv4 and PublicIPv6 leave out, from the IANA special-purpose address registries.
+var (
+	reservedIPv4 = mustPrefixes(
+		"0.0.0.0/8", "10.0.0.0/8", "100.64.0.0/10", "127.0.0.0/8", "169.254.0.0/16", "172.16.0.0/12",
+		"192.0.0.0/24", "192.0.2.0/24", "192.88.99.0/24", "192.168.0.0/16", "198.18.0.0/15",
+		"198.51.100.0/24", "203.0.113.0/24", "224.0.0.0/4", "240.0.0.0/4",
+	)
+	reservedIPv6 = mustPrefixes("2001::/23", "2001:db8::/32", "2002::/16", "3fff::/20")
+	privateIPv4  = mustPrefixes("10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16")
+)
+
+// IPInPrefix function is used to get a random address inside the prefix p.
+// Any address of p can be returned, including the network and broadcast addresses of an IPv4 subnet.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the randomly generated address of type netip.Addr and any write error encountered.
+// example: random.IPInPrefix(netip.MustParsePrefix("10.1.0.0/16"), nil), returns an address like 10.1.183.7.
+func IPInPrefix(p netip.Prefix, r *rand.Rand) (netip.Addr, error) {
+	const fn = "IPInPrefix"
+	if !p.IsValid() {
+		return netip.Addr{}, &Error{fn, fmt.Errorf("%w: invalid prefix", ErrInvalid)}
+	}
+	return prefixAddr(p.Masked(), rng(r)), nil
+}
+
+// IPRange function is used to get a random address between a range [start, end].
+// start and end must both be IPv4 or both be IPv6 addresses.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the randomly generated address of type netip.Addr and any write error encountered.
+// example: random.IPRange(netip.MustParseAddr("192.168.1.100"), netip.MustParseAddr("192.168.1.200"), nil), returns an address like 192.168.1.142.
+func IPRange(start netip.Addr, end netip.Addr, r *rand.Rand) (netip.Addr, error) {
+	const fn = "IPRange"
+	if !start.IsValid() || !end.IsValid() || start.Is4() != end.Is4() {
+		return netip.Addr{}, &Error{fn, fmt.Errorf("%w: start and end must be valid addresses of the same family", ErrInvalid)}
+	}
+	if start.Compare(end) >= 0 {
+		return netip.Addr{}, &Error{fn, ErrEndNumSmaller}
+	}
+	r = rng(r)
+	s := start.As16()
+	e := end.As16()
+	hi0, lo0 := uint128(s)
+	hi1, lo1 := uint128(e)
+	spanLo, borrow := bits.Sub64(lo1, lo0, 0)
+	spanHi, _ := bits.Sub64(hi1, hi0, borrow)
+	var hi, lo uint64
+	if spanHi == 0 {
+		// Spans of up to 2^64 addresses, like all the IPv4 ranges, are drawn with int64Range shifted to start at math.MinInt64.
+		lo = uint64(int64Range(math.MinInt64, math.MinInt64+int64(spanLo), r) - math.MinInt64)
+	} else {
+		// Wider spans are drawn by rejection, masking to the bit length of the span keeps the acceptance above 1/2.
+		maskHi := ^uint64(0) >> bits.LeadingZeros64(spanHi)
+		for {
+			hi, lo = r.Uint64()&maskHi, r.Uint64()
+			if hi < spanHi || (hi == spanHi && lo <= spanLo) {
+				break
+			}
+		}
+	}
+	lo, carry := bits.Add64(lo0, lo, 0)
+	hi, _ = bits.Add64(hi0, hi, carry)
+	a := netip.AddrFrom16(fromUint128(hi, lo))
+	if start.Is4() {
+		a = a.Unmap()
+	}
+	return a, nil
+}
+
+// UniqueIPs function is used to get n distinct random addresses inside the prefix p, in random order.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the randomly generated addresses in a slice of type []netip.Addr and any write error encountered,
+// ErrExceed if p holds less than n addresses.
+// example: random.UniqueIPs(netip.MustParsePrefix("192.168.0.0/24"), 10, nil), returns 10 different addresses of the subnet.
+func UniqueIPs(p netip.Prefix, n int, r *rand.Rand) ([]netip.Addr, error) {
+	const fn = "UniqueIPs"
+	if !p.IsValid() {
+		return nil, &Error{fn, fmt.Errorf("%w: invalid prefix", ErrInvalid)}
+	}
+	if n < 0 {
+		return nil, &Error{fn, fmt.Errorf("%w: n must not be negative", ErrInvalid)}
+	}
+	p = p.Masked()
+	r = rng(r)
+	hostBits := p.Addr().BitLen() - p.Bits()
+	if hostBits < 63 && uint64(n) > uint64(1)<<hostBits {
+		return nil, &Error{fn, ErrExceed}
+	}
+	out := make([]netip.Addr, 0, n)
+	if hostBits < 63 && uint64(n) > uint64(1)<<hostBits/2 {
+		// A dense set is cheaper to draw as the first n addresses of a shuffled prefix.
+		hi, lo := uint128(p.Addr().As16())
+		for _, o := range r.Perm(1 << hostBits)[:n] {
+			a := netip.AddrFrom16(fromUint128(hi, lo+uint64(o)))
+			if p.Addr().Is4() {
+				a = a.Unmap()
+			}
+			out = append(out, a)
+		}
+		return out, nil
+	}
+	seen := make(map[netip.Addr]bool, n)
+	for len(out) < n {
+		a := prefixAddr(p, r)
+		if !seen[a] {
+			seen[a] = true
+			out = append(out, a)
+		}
+	}
+	return out, nil
+}
+
+// PublicIPv4 function is used to get a random globally routable IPv4 address.
+// Private, loopback, link-local, documentation, multicast and other special-purpose addresses are never returned.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// example: random.PublicIPv4(nil), returns an address like 81.23.190.4.
+func PublicIPv4(r *rand.Rand) netip.Addr {
+	r = rng(r)
+	for {
+		a := prefixAddr(netip.PrefixFrom(netip.IPv4Unspecified(), 0), r)
+		if !inPrefixes(a, reservedIPv4) {
+			return a
+		}
+	}
+}
+
+// PublicIPv6 function is used to get a random global unicast IPv6 address from 2000::/3.
+// Documentation, 6to4 and IETF protocol assignment addresses are never returned.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// example: random.PublicIPv6(nil), returns an address like 2a03:9c1:4e0f:2b7:8d21:7b3a:c0e5:19f.
+func PublicIPv6(r *rand.Rand) netip.Addr {
+	r = rng(r)
+	global := netip.MustParsePrefix("2000::/3")
+	for {
+		a := prefixAddr(global, r)
+		if !inPrefixes(a, reservedIPv6) {
+			return a
+		}
+	}
+}
+
+// PrivateIPv4 function is used to get a random RFC 1918 private IPv4 address, from 10.0.0.0/8, 172.16.0.0/12 or 192.168.0.0/16.
+// Every address is equally likely, so most of them fall in 10.0.0.0/8.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// example: random.PrivateIPv4(nil), returns an address like 10.84.3.211.
+func PrivateIPv4(r *rand.Rand) netip.Addr {
+	r = rng(r)
+	// The blocks hold 2^24, 2^20 and 2^16 addresses.
+	n := int64Range(0, 1<<24+1<<20+1<<16-1, r)
+	switch {
+	case n < 1<<24:
+		return prefixAddr(privateIPv4[0], r)
+	case n < 1<<24+1<<20:
+		return prefixAddr(privateIPv4[1], r)
+	default:
+		return prefixAddr(privateIPv4[2], r)
+	}
+}
+
+// PrivateIPv6 function is used to get a random unique local IPv6 address from fd00::/8, with a random global ID as RFC 4193 asks.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// example: random.PrivateIPv6(nil), returns an address like fd3c:91a2:7e04:1::52b.
+func PrivateIPv6(r *rand.Rand) netip.Addr {
+	return prefixAddr(netip.MustParsePrefix("fd00::/8"), rng(r))
+}
+
+// LinkLocalIPv4 function is used to get a random IPv4 link-local address from 169.254.0.0/16.
+// The first and the last 256 addresses, which RFC 3927 reserves, are never returned.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// example: random.LinkLocalIPv4(nil), returns an address like 169.254.37.180.
+func LinkLocalIPv4(r *rand.Rand) netip.Addr {
+	a, _ := IPRange(netip.AddrFrom4([4]byte{169, 254, 1, 0}), netip.AddrFrom4([4]byte{169, 254, 254, 255}), r)
+	return a
+}
+
+// LinkLocalIPv6 function is used to get a random IPv6 link-local address from fe80::/64.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// example: random.LinkLocalIPv6(nil), returns an address like fe80::7c1e:44ff:fe0a:93d2.
+func LinkLocalIPv6(r *rand.Rand) netip.Addr {
+	return prefixAddr(netip.MustParsePrefix("fe80::/64"), rng(r))
+}
+
+// MAC function is used to get a random 48-bit MAC address.
+// The address is unicast and has the locally administered bit set, so it can't clash with a vendor-assigned address.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// example: random.MAC(nil), returns an address like 6a:1f:c3:08:9e:d4.
+func MAC(r *rand.Rand) net.HardwareAddr {
+	r = rng(r)
+	mac := make(net.HardwareAddr, 6)
+	for i := range mac {
+		mac[i] = byte(int64Range(0, math.MaxUint8, r))
+	}
+	mac[0] = mac[0]&^0x01 | 0x02
+	return mac
+}
+
+// Port function is used to get a random port number between a range [startNum, endNum].
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the randomly chosen port of type uint16 and any write error encountered.
+// example: random.Port(8000, 8999, nil), returns any one port from the range [8000, 8999].
+func Port(startNum uint16, endNum uint16, r *rand.Rand) (uint16, error) {
+	d, err := NewUniformInteger(int(startNum), int(endNum))
+	if err != nil {
+		return 0, &Error{"Port", errors.Unwrap(err)}
+	}
+	d.Rand = r
+	return uint16(d.Sample()), nil
+}
+
+// EphemeralPort function is used to get a random port number from the range [EphemeralPortStart, EphemeralPortEnd].
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// example: random.EphemeralPort(nil), returns any one port from the range [49152, 65535].
+func EphemeralPort(r *rand.Rand) uint16 {
+	p, _ := Port(EphemeralPortStart, EphemeralPortEnd, r)
+	return p
+}
+
+// prefixAddr is one of the inner functions of this package.
+// It returns a random address of the masked prefix p.
+func prefixAddr(p netip.Prefix, r *rand.Rand) netip.Addr {
+	b := p.Addr().As16()
+	offset := 16 - p.Addr().BitLen()/8
+	for i := offset; i < 16; i++ {
+		// hostBits is the number of host bits in byte i.
+		hostBits := (i-offset+1)*8 - p.Bits()
+		switch {
+		case hostBits >= 8:
+			b[i] = byte(int64Range(0, math.MaxUint8, r))
+		case hostBits > 0:
+			b[i] |= byte(int64Range(0, 1<<hostBits-1, r))
+		}
+	}
+	a := netip.AddrFrom16(b)
+	if p.Addr().Is4() {
+		a = a.Unmap()
+	}
+	return a
+}
+
+// inPrefixes is one of the inner functions of this package.
+// It reports whether a is inside any of prefixes.
+func inPrefixes(a netip.Addr, prefixes []netip.Prefix) bool {
+	for _, p := range prefixes {
+		if p.Contains(a) {
+			return true
+		}
+	}
+	return false
+}
+
+// mustPrefixes is one of the inner functions of this package.
+// It parses the prefixes in s and panics if one is invalid.
+func mustPrefixes(s ...string) []netip.Prefix {
+	p := make([]netip.Prefix, len(s))
+	for i := range s {
+		p[i] = netip.MustParsePrefix(s[i])
+	}
+	return p
+}
+
+// uint128 is one of the inner functions of this package.
+// It returns the high and low halves of the big-endian 128-bit number b.
+func uint128(b [16]byte) (uint64, uint64) {
+	var hi, lo uint64
+	for i := 0; i < 8; i++ {
+		hi = hi<<8 | uint64(b[i])
+		lo = lo<<8 | uint64(b[i+8])
+	}
+	return hi, lo
+}
+
+// fromUint128 is one of the inner functions of this package.
+// It returns the big-endian bytes of the 128-bit number with the halves hi and lo.
+func fromUint128(hi uint64, lo uint64) [16]byte {
+	var b [16]byte
+	for i := 7; i >= 0; i-- {
+		b[i], b[i+8] = byte(hi), byte(lo)
+		hi >>= 8
+		lo >>= 8
+	}
+	return b
+}
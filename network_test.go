@@ -0,0 +1,79 @@
+/*
+ * File: network_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random_test
+
+import (
+	"errors"
+	"net/netip"
+	"testing"
+
+	"github.com/anonyindian/random-go"
+)
+
+func TestPort(t *testing.T) {
+	r := random.NewRand(1)
+	seen := make(map[uint16]bool)
+	for i := 0; i < 1000; i++ {
+		p, err := random.Port(8000, 8003, r)
+		if err != nil || p < 8000 || p > 8003 {
+			t.Fatalf("Port(8000, 8003) = %d, %v", p, err)
+		}
+		seen[p] = true
+	}
+	if len(seen) != 4 {
+		t.Errorf("Port(8000, 8003) drew %d distinct ports, want 4", len(seen))
+	}
+	if _, err := random.Port(9, 9, r); !errors.Is(err, random.ErrEndNumSmaller) {
+		t.Errorf("Port(9, 9) error = %v, want ErrEndNumSmaller", err)
+	}
+	if p := random.EphemeralPort(r); p < random.EphemeralPortStart {
+		t.Errorf("EphemeralPort() = %d", p)
+	}
+}
+
+func TestIPRange(t *testing.T) {
+	r := random.NewRand(2)
+	for _, c := range [][2]string{
+		{"10.0.0.254", "10.0.1.1"},
+		{"0.0.0.0", "255.255.255.255"},
+		{"::", "::ffff:ffff:ffff:ffff"},
+		{"::1", "ffff::"},
+	} {
+		start, end := netip.MustParseAddr(c[0]), netip.MustParseAddr(c[1])
+		for i := 0; i < 200; i++ {
+			a, err := random.IPRange(start, end, r)
+			if err != nil || a.Compare(start) < 0 || a.Compare(end) > 0 || a.Is4() != start.Is4() {
+				t.Fatalf("IPRange(%s, %s) = %s, %v", start, end, a, err)
+			}
+		}
+	}
+	if _, err := random.IPRange(netip.MustParseAddr("10.0.0.1"), netip.MustParseAddr("::1"), r); !errors.Is(err, random.ErrInvalid) {
+		t.Errorf("IPRange of mixed families error = %v, want ErrInvalid", err)
+	}
+}
+
+func TestMAC(t *testing.T) {
+	mac := random.MAC(random.NewRand(3))
+	if len(mac) != 6 || mac[0]&0x01 != 0 || mac[0]&0x02 == 0 {
+		t.Errorf("MAC() = %s, want a locally administered unicast address", mac)
+	}
+}
@@ -0,0 +1,290 @@
+/*
+ * File: password.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// ambiguousRunes are the characters which PasswordPolicy.ExcludeAmbiguous leaves out because they are easily confused.
+const ambiguousRunes = "Il1|O0o`'\""
+
+// maxPasswordTries is the number of attempts Password makes to satisfy a policy with overlapping classes and NoRepeat.
+const maxPasswordTries = 100
+
+// PasswordClass is a class of characters a password is made of, like lowercase letters or digits.
+type PasswordClass struct {
+	Alphabet Alphabet // the runes of the class
+	Min      int      // the minimum number of runes from this class, 0 makes the class optional
+}
+
+// PasswordPolicy describes the passwords generated by Password.
+type PasswordPolicy struct {
+	Length           int             // the length of the password in runes
+	Classes          []PasswordClass // the classes the password is made of
+	ExcludeAmbiguous bool            // leave out characters which are easily confused, like I, l, 1, O and 0
+	NoRepeat         bool            // use every rune at most once
+	Rand             *rand.Rand      // the generator used, nil uses a generator backed by SecureSource
+}
+
+// DefaultPasswordPolicy is a policy for passwords of 16 characters with at least one
+// lowercase letter, uppercase letter, digit and symbol each.
+var DefaultPasswordPolicy = PasswordPolicy{
+	Length: 16,
+	Classes: []PasswordClass{
+		{AlphabetLower, 1},
+		{AlphabetUpper, 1},
+		{AlphabetDigits, 1},
+		{AlphabetSymbols, 1},
+	},
+}
+
+// Password function is used to generate a random password following policy.
+// The required runes of every class are drawn first, the rest are drawn from all the classes and the result is shuffled.
+// It returns the generated password and any write error encountered.
+// example: random.Password(random.DefaultPasswordPolicy), returns a password like "q7G#vT2m!xPa9_Lr".
+func Password(policy PasswordPolicy) (string, error) {
+	const fn = "Password"
+	classes := make([]Alphabet, len(policy.Classes))
+	var pool Alphabet
+	inPool := make(map[rune]bool)
+	required := 0
+	for i, c := range policy.Classes {
+		if c.Min < 0 {
+			return "", &Error{fn, fmt.Errorf("%w: class %d has a negative Min", ErrInvalid, i)}
+		}
+		inClass := make(map[rune]bool)
+		for _, x := range c.Alphabet {
+			if inClass[x] || (policy.ExcludeAmbiguous && strings.ContainsRune(ambiguousRunes, x)) {
+				continue
+			}
+			inClass[x] = true
+			classes[i] = append(classes[i], x)
+			if !inPool[x] {
+				inPool[x] = true
+				pool = append(pool, x)
+			}
+		}
+		if c.Min > 0 && (len(classes[i]) == 0 || (policy.NoRepeat && len(classes[i]) < c.Min)) {
+			return "", &Error{fn, fmt.Errorf("%w: class %d has too few characters for its Min", ErrInvalid, i)}
+		}
+		required += c.Min
+	}
+	if len(pool) == 0 {
+		return "", &Error{fn, ErrEmpty}
+	}
+	if policy.Length < required {
+		return "", &Error{fn, fmt.Errorf("%w: Length is less than the sum of the Min of all classes", ErrInvalid)}
+	}
+	if policy.NoRepeat && policy.Length > len(pool) {
+		return "", &Error{fn, fmt.Errorf("%w: Length exceeds the number of characters and NoRepeat is set", ErrInvalid)}
+	}
+	r := policy.Rand
+	if r == nil {
+		r = NewSecureRand()
+	}
+	for try := 0; try < maxPasswordTries; try++ {
+		if p, ok := drawPassword(policy, classes, pool, r); ok {
+			return p, nil
+		}
+	}
+	return "", &Error{fn, fmt.Errorf("%w: the classes overlap too much to satisfy NoRepeat", ErrInvalid)}
+}
+
+// drawPassword is one of the inner functions of this package.
+// It draws a password from the filtered classes and pool, it reports false if NoRepeat ran out of characters.
+func drawPassword(policy PasswordPolicy, classes []Alphabet, pool Alphabet, r *rand.Rand) (string, bool) {
+	used := make(map[rune]bool)
+	p := make([]rune, 0, policy.Length)
+	draw := func(a Alphabet, n int) bool {
+		for ; n > 0; n-- {
+			if !policy.NoRepeat {
+				p = append(p, a[r.Intn(len(a))])
+				continue
+			}
+			var free Alphabet
+			for _, x := range a {
+				if !used[x] {
+					free = append(free, x)
+				}
+			}
+			if len(free) == 0 {
+				return false
+			}
+			x := free[r.Intn(len(free))]
+			used[x] = true
+			p = append(p, x)
+		}
+		return true
+	}
+	for i, c := range policy.Classes {
+		if !draw(classes[i], c.Min) {
+			return "", false
+		}
+	}
+	if !draw(pool, policy.Length-len(p)) {
+		return "", false
+	}
+	r.Shuffle(len(p), func(i, j int) {
+		p[i], p[j] = p[j], p[i]
+	})
+	return string(p), true
+}
+
+// Capitalization decides how Passphrase capitalizes its words.
+type Capitalization int
+
+const (
+	CapitalizeNone   Capitalization = iota // keep the words as they are in the list
+	CapitalizeFirst                        // capitalize the first letter of every word
+	CapitalizeAll                          // write every word in uppercase
+	CapitalizeRandom                       // capitalize the first letter of every word with a probability of 1/2
+)
+
+// Passphrase is a Diceware-style passphrase generator, which joins words drawn from a word list.
+// It implements Distribution[string].
+type Passphrase struct {
+	Words          int            // the number of words in a passphrase
+	Separator      string         // the string put between the words
+	Capitalization Capitalization // the way the words are capitalized
+	Rand           *rand.Rand     // the generator used, nil uses a generator backed by SecureSource
+
+	list []string
+}
+
+// NewPassphrase function is used to get a Passphrase generator of the given number of words separated by spaces, drawn from a word list.
+// The word list is read from r with one word per line, lines in the Diceware format like "11111 abacus" are supported
+// by taking their last field. Empty lines and duplicate words are ignored.
+// It returns the generator of type *Passphrase and any write error encountered.
+// example: random.NewPassphrase(file, 6), returns a generator of passphrases like "crane pebble oxygen mural tiger sleet".
+func NewPassphrase(r io.Reader, words int) (*Passphrase, error) {
+	const fn = "NewPassphrase"
+	if words < 1 {
+		return nil, &Error{fn, fmt.Errorf("%w: words must be positive", ErrInvalid)}
+	}
+	seen := make(map[string]bool)
+	var list []string
+	s := bufio.NewScanner(r)
+	for s.Scan() {
+		f := strings.Fields(s.Text())
+		if len(f) == 0 || seen[f[len(f)-1]] {
+			continue
+		}
+		seen[f[len(f)-1]] = true
+		list = append(list, f[len(f)-1])
+	}
+	if err := s.Err(); err != nil {
+		return nil, &Error{fn, err}
+	}
+	if len(list) < 2 {
+		return nil, &Error{fn, fmt.Errorf("%w: need at least 2 distinct words", ErrEmpty)}
+	}
+	return &Passphrase{Words: words, Separator: " ", list: list}, nil
+}
+
+// Sample generates a random passphrase.
+// It returns an empty string if Words is less than 1, use Generate to get the error.
+func (p *Passphrase) Sample() string {
+	s, _ := p.Generate()
+	return s
+}
+
+// Generate function is used to generate a random passphrase.
+// It returns the generated passphrase and any write error encountered.
+// example: p.Generate(), returns a passphrase like "crane pebble oxygen mural tiger sleet".
+func (p *Passphrase) Generate() (string, error) {
+	const fn = "Passphrase.Generate"
+	if p.Words < 1 {
+		return "", &Error{fn, fmt.Errorf("%w: Words must be positive", ErrInvalid)}
+	}
+	r := p.Rand
+	if r == nil {
+		r = NewSecureRand()
+	}
+	words := make([]string, p.Words)
+	for i := range words {
+		w := p.list[r.Intn(len(p.list))]
+		switch p.Capitalization {
+		case CapitalizeFirst:
+			w = capitalize(w)
+		case CapitalizeAll:
+			w = strings.ToUpper(w)
+		case CapitalizeRandom:
+			if r.Intn(2) == 1 {
+				w = capitalize(w)
+			}
+		}
+		words[i] = w
+	}
+	return strings.Join(words, p.Separator), nil
+}
+
+// SampleN generates n random passphrases.
+func (p *Passphrase) SampleN(n int) []string {
+	return sampleN[string](p, n)
+}
+
+// Entropy function returns the entropy of the generated passphrases in bits,
+// assuming the attacker knows the word list and the settings of p.
+// It is computed over the distinct words p can write, so words of the list which only differ in case
+// and collide once capitalized count once, and CapitalizeRandom adds a bit only for the words it can change.
+// example: 6 words from the 7776 words Diceware list give an entropy of about 77.5 bits.
+func (p *Passphrase) Entropy() float64 {
+	if p.Words < 1 {
+		return 0
+	}
+	// prob holds the probability of every word p can write.
+	prob := make(map[string]float64)
+	each := 1 / float64(len(p.list))
+	for _, w := range p.list {
+		switch p.Capitalization {
+		case CapitalizeFirst:
+			prob[capitalize(w)] += each
+		case CapitalizeAll:
+			prob[strings.ToUpper(w)] += each
+		case CapitalizeRandom:
+			prob[w] += each / 2
+			prob[capitalize(w)] += each / 2
+		default:
+			prob[w] += each
+		}
+	}
+	bits := 0.0
+	for _, q := range prob {
+		bits -= q * math.Log2(q)
+	}
+	return float64(p.Words) * bits
+}
+
+// capitalize is one of the inner functions of this package.
+// It returns w with its first rune in title case.
+func capitalize(w string) string {
+	c, size := utf8.DecodeRuneInString(w)
+	return string(unicode.ToTitle(c)) + w[size:]
+}
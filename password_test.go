@@ -0,0 +1,74 @@
+/*
+ * File: password_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/anonyindian/random-go"
+)
+
+func TestPassphraseEntropy(t *testing.T) {
+	// "apple" and "Apple" collide once capitalized, and "42" and "7" have no upper case.
+	p, err := random.NewPassphrase(strings.NewReader("apple\nApple\n42\n7\n"), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, c := range []struct {
+		capitalization random.Capitalization
+		bits           float64
+	}{
+		{random.CapitalizeNone, 2 * 2},
+		{random.CapitalizeFirst, 2 * 1.5},
+		{random.CapitalizeAll, 2 * 1.5},
+		// apple, Apple, 42 and 7 are written with probabilities 1/8, 3/8, 1/4 and 1/4.
+		{random.CapitalizeRandom, 2 * (3.0/8 + 3.0/8*math.Log2(8.0/3) + 1)},
+	} {
+		p.Capitalization = c.capitalization
+		if got := p.Entropy(); math.Abs(got-c.bits) > 1e-12 {
+			t.Errorf("Entropy with capitalization %d = %g, want %g", c.capitalization, got, c.bits)
+		}
+	}
+}
+
+func TestPassphraseWords(t *testing.T) {
+	if _, err := random.NewPassphrase(strings.NewReader("a\nb\n"), 0); !errors.Is(err, random.ErrInvalid) {
+		t.Errorf("NewPassphrase with 0 words error = %v, want ErrInvalid", err)
+	}
+	p, err := random.NewPassphrase(strings.NewReader("a\nb\n"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s, err := p.Generate(); err != nil || len(strings.Fields(s)) != 3 {
+		t.Errorf("Generate() = %q, %v", s, err)
+	}
+	p.Words = -1
+	if _, err := p.Generate(); !errors.Is(err, random.ErrInvalid) {
+		t.Errorf("Generate with -1 words error = %v, want ErrInvalid", err)
+	}
+	if s := p.Sample(); s != "" {
+		t.Errorf("Sample with -1 words = %q", s)
+	}
+}
@@ -0,0 +1,208 @@
+/*
+ * File: perm.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// MaxRankPerm is the longest permutation RankPerm and UnrankPerm support, 20! is the largest factorial below 2^64.
+const MaxRankPerm = 20
+
+// Perm function is used to get a uniform random permutation of the integers [0, n).
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the permutation in a slice of type []int and any write error encountered.
+// example: random.Perm(5, nil), returns a permutation like [3 0 4 1 2].
+func Perm(n int, r *rand.Rand) ([]int, error) {
+	const fn = "Perm"
+	if n < 0 {
+		return nil, &Error{fn, fmt.Errorf("%w: n must not be negative", ErrInvalid)}
+	}
+	return rng(r).Perm(n), nil
+}
+
+// Derangement function is used to get a uniform random derangement of the integers [0, n),
+// a permutation p where no element stays in place, p[i] != i for every i, like the draw of a secret santa.
+// Random permutations are drawn until one is a derangement, which takes e draws on average.
+// There is no derangement of 1 element, the derangement of 0 elements is empty.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the derangement in a slice of type []int and any write error encountered.
+// example: random.Derangement(4, nil), returns a derangement like [2 3 1 0].
+func Derangement(n int, r *rand.Rand) ([]int, error) {
+	const fn = "Derangement"
+	if n < 0 {
+		return nil, &Error{fn, fmt.Errorf("%w: n must not be negative", ErrInvalid)}
+	}
+	if n == 1 {
+		return nil, &Error{fn, fmt.Errorf("%w: there is no derangement of 1 element", ErrInvalid)}
+	}
+	r = rng(r)
+	p := make([]int, n)
+	for {
+		for i := range p {
+			p[i] = i
+		}
+		// Fisher-Yates from the end, giving up as soon as an element is left in place.
+		ok := true
+		for i := n - 1; i >= 0 && ok; i-- {
+			j := r.Intn(i + 1)
+			p[i], p[j] = p[j], p[i]
+			ok = p[i] != i
+		}
+		if ok {
+			return p, nil
+		}
+	}
+}
+
+// Sattolo function is used to get a uniform random cyclic permutation of the integers [0, n) with Sattolo's algorithm.
+// Following p from any element visits all the elements before coming back, like a random tour or a ring of test pairs.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the permutation in a slice of type []int and any write error encountered.
+// example: random.Sattolo(4, nil), returns a permutation like [2 0 3 1], which is the cycle 0 -> 2 -> 3 -> 1 -> 0.
+func Sattolo(n int, r *rand.Rand) ([]int, error) {
+	const fn = "Sattolo"
+	if n < 0 {
+		return nil, &Error{fn, fmt.Errorf("%w: n must not be negative", ErrInvalid)}
+	}
+	r = rng(r)
+	p := make([]int, n)
+	for i := range p {
+		p[i] = i
+	}
+	for i := n - 1; i > 0; i-- {
+		j := r.Intn(i)
+		p[i], p[j] = p[j], p[i]
+	}
+	return p, nil
+}
+
+// InversePerm function is used to get the inverse of the permutation p, q[p[i]] = i for every i.
+// It returns the inverse in a slice of type []int and any write error encountered.
+// example: random.InversePerm([]int{2, 0, 1}), returns [1 2 0].
+func InversePerm(p []int) ([]int, error) {
+	const fn = "InversePerm"
+	if err := checkPerm(p); err != nil {
+		return nil, &Error{fn, err}
+	}
+	q := make([]int, len(p))
+	for i, x := range p {
+		q[x] = i
+	}
+	return q, nil
+}
+
+// ComposePerm function is used to compose the permutations p and q of the same length, the result c applies q first,
+// c[i] = p[q[i]] for every i.
+// It returns the composition in a slice of type []int and any write error encountered.
+// example: random.ComposePerm([]int{2, 0, 1}, []int{1, 2, 0}), returns [0 1 2].
+func ComposePerm(p []int, q []int) ([]int, error) {
+	const fn = "ComposePerm"
+	if len(p) != len(q) {
+		return nil, &Error{fn, fmt.Errorf("%w: p and q must have the same length", ErrInvalid)}
+	}
+	if err := checkPerm(p); err != nil {
+		return nil, &Error{fn, err}
+	}
+	if err := checkPerm(q); err != nil {
+		return nil, &Error{fn, err}
+	}
+	c := make([]int, len(p))
+	for i := range q {
+		c[i] = p[q[i]]
+	}
+	return c, nil
+}
+
+// RankPerm function is used to get the rank of the permutation p in the lexicographic order of all the permutations
+// of its length, from 0 for the identity to n!-1 for the reversed identity. p must not be longer than MaxRankPerm.
+// It returns the rank of type uint64 and any write error encountered.
+// example: random.RankPerm([]int{1, 0, 2}), returns 2.
+func RankPerm(p []int) (uint64, error) {
+	const fn = "RankPerm"
+	if len(p) > MaxRankPerm {
+		return 0, &Error{fn, fmt.Errorf("%w: p is longer than %d", ErrExceed, MaxRankPerm)}
+	}
+	if err := checkPerm(p); err != nil {
+		return 0, &Error{fn, err}
+	}
+	// The digits of the rank in the factorial number system count the smaller elements to the right.
+	var rank uint64
+	for i := range p {
+		smaller := 0
+		for _, x := range p[i+1:] {
+			if x < p[i] {
+				smaller++
+			}
+		}
+		rank = rank*uint64(len(p)-i) + uint64(smaller)
+	}
+	return rank, nil
+}
+
+// UnrankPerm function is used to get the permutation of the integers [0, n) of the given lexicographic rank,
+// the inverse of RankPerm. n must not be greater than MaxRankPerm and rank must be less than n!.
+// It returns the permutation in a slice of type []int and any write error encountered.
+// example: random.UnrankPerm(3, 2), returns [1 0 2].
+func UnrankPerm(n int, rank uint64) ([]int, error) {
+	const fn = "UnrankPerm"
+	if n < 0 {
+		return nil, &Error{fn, fmt.Errorf("%w: n must not be negative", ErrInvalid)}
+	}
+	if n > MaxRankPerm {
+		return nil, &Error{fn, fmt.Errorf("%w: n is greater than %d", ErrExceed, MaxRankPerm)}
+	}
+	fact := uint64(1)
+	for i := 2; i <= n; i++ {
+		fact *= uint64(i)
+	}
+	if rank >= fact {
+		return nil, &Error{fn, fmt.Errorf("%w: rank must be less than %d! = %d", ErrInvalid, n, fact)}
+	}
+	rest := make([]int, n)
+	for i := range rest {
+		rest[i] = i
+	}
+	p := make([]int, n)
+	for i := range p {
+		fact /= uint64(n - i)
+		d := int(rank / fact)
+		rank %= fact
+		p[i] = rest[d]
+		rest = append(rest[:d], rest[d+1:]...)
+	}
+	return p, nil
+}
+
+// checkPerm is one of the inner functions of this package.
+// It checks that p holds every integer of [0, len(p)) exactly once.
+func checkPerm(p []int) error {
+	seen := make([]bool, len(p))
+	for i, x := range p {
+		if x < 0 || x >= len(p) || seen[x] {
+			return fmt.Errorf("%w: element %d is %d, p is not a permutation", ErrInvalid, i, x)
+		}
+		seen[x] = true
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+/*
+ * File: perm_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/anonyindian/random-go"
+	"github.com/anonyindian/random-go/randtest"
+)
+
+// alpha is the significance level of the uniformity tests, low enough that a fixed seed never fails by chance.
+const alpha = 1e-4
+
+// drawsPerOutcome is the expected count of every outcome in the uniformity tests.
+const drawsPerOutcome = 1000
+
+func factorial(n int) int {
+	f := 1
+	for i := 2; i <= n; i++ {
+		f *= i
+	}
+	return f
+}
+
+// checkUniform draws drawsPerOutcome permutations for every outcome and checks that they are spread
+// uniformly over the outcomes, given by their rank.
+func checkUniform(t *testing.T, name string, outcomes []uint64, draw func() []int) {
+	t.Helper()
+	index := make(map[uint64]int, len(outcomes))
+	for i, o := range outcomes {
+		index[o] = i
+	}
+	counts := make([]int, len(outcomes))
+	for i := 0; i < len(outcomes)*drawsPerOutcome; i++ {
+		p := draw()
+		rank, err := random.RankPerm(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		j, ok := index[rank]
+		if !ok {
+			t.Fatalf("%s drew %v, which is not a possible outcome", name, p)
+		}
+		counts[j]++
+	}
+	if len(counts) < 2 {
+		return
+	}
+	res, err := randtest.ChiSquareUniform(counts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Passed(alpha) {
+		t.Errorf("%s is not uniform: chi-square %g, p-value %g, counts %v", name, res.Statistic, res.PValue, counts)
+	}
+}
+
+// outcomes returns the ranks of the permutations of [0, n) for which keep is true.
+func outcomes(t *testing.T, n int, keep func(p []int) bool) []uint64 {
+	var ranks []uint64
+	for rank := uint64(0); rank < uint64(factorial(n)); rank++ {
+		p, err := random.UnrankPerm(n, rank)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if keep(p) {
+			ranks = append(ranks, rank)
+		}
+	}
+	return ranks
+}
+
+func isDerangement(p []int) bool {
+	for i, v := range p {
+		if i == v {
+			return false
+		}
+	}
+	return true
+}
+
+func isCycle(p []int) bool {
+	i, steps := 0, 0
+	for {
+		i = p[i]
+		steps++
+		if i == 0 {
+			return steps == len(p)
+		}
+	}
+}
+
+func TestPermUniform(t *testing.T) {
+	r := random.NewRand(1)
+	for n := 1; n <= 4; n++ {
+		all := outcomes(t, n, func([]int) bool { return true })
+		checkUniform(t, "Perm", all, func() []int {
+			p, err := random.Perm(n, r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return p
+		})
+	}
+}
+
+func TestDerangementUniform(t *testing.T) {
+	r := random.NewRand(2)
+	// There are 1, 2 and 9 derangements of 2, 3 and 4 elements.
+	for _, c := range []struct{ n, want int }{{2, 1}, {3, 2}, {4, 9}} {
+		n := c.n
+		all := outcomes(t, n, isDerangement)
+		if len(all) != c.want {
+			t.Fatalf("found %d derangements of %d elements, want %d", len(all), n, c.want)
+		}
+		checkUniform(t, "Derangement", all, func() []int {
+			p, err := random.Derangement(n, r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return p
+		})
+	}
+	if _, err := random.Derangement(1, r); !errors.Is(err, random.ErrInvalid) {
+		t.Errorf("Derangement(1) error = %v, want ErrInvalid", err)
+	}
+}
+
+func TestSattoloUniform(t *testing.T) {
+	r := random.NewRand(3)
+	for n := 2; n <= 5; n++ {
+		all := outcomes(t, n, isCycle)
+		if len(all) != factorial(n-1) {
+			t.Fatalf("found %d cycles of %d elements, want %d", len(all), n, factorial(n-1))
+		}
+		checkUniform(t, "Sattolo", all, func() []int {
+			p, err := random.Sattolo(n, r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			return p
+		})
+	}
+}
+
+func TestRankRoundTrip(t *testing.T) {
+	for n := 0; n <= 6; n++ {
+		for rank := uint64(0); rank < uint64(factorial(n)); rank++ {
+			p, err := random.UnrankPerm(n, rank)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got, err := random.RankPerm(p); err != nil || got != rank {
+				t.Fatalf("RankPerm(UnrankPerm(%d, %d)) = %d, %v", n, rank, got, err)
+			}
+		}
+	}
+	// The last rank of the longest supported permutation is the reversed identity.
+	last := uint64(1)
+	for i := uint64(2); i <= random.MaxRankPerm; i++ {
+		last *= i
+	}
+	last--
+	p, err := random.UnrankPerm(random.MaxRankPerm, last)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, v := range p {
+		if v != random.MaxRankPerm-1-i {
+			t.Fatalf("UnrankPerm(%d, %d) = %v, want the reversed identity", random.MaxRankPerm, last, p)
+		}
+	}
+	if _, err := random.UnrankPerm(random.MaxRankPerm, math.MaxUint64); err == nil {
+		t.Error("UnrankPerm accepted a rank of at least n!")
+	}
+	if _, err := random.RankPerm(make([]int, random.MaxRankPerm+1)); !errors.Is(err, random.ErrExceed) {
+		t.Errorf("RankPerm of %d elements error = %v, want ErrExceed", random.MaxRankPerm+1, err)
+	}
+}
+
+func TestInverseCompose(t *testing.T) {
+	r := random.NewRand(4)
+	for i := 0; i < 100; i++ {
+		p, _ := random.Perm(9, r)
+		q, _ := random.Perm(9, r)
+		inv, err := random.InversePerm(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, c := range [][2][]int{{p, inv}, {inv, p}} {
+			id, err := random.ComposePerm(c[0], c[1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			for j, v := range id {
+				if v != j {
+					t.Fatalf("composing %v and its inverse %v gave %v", p, inv, id)
+				}
+			}
+		}
+		pq, err := random.ComposePerm(p, q)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for j := range pq {
+			if pq[j] != p[q[j]] {
+				t.Fatalf("ComposePerm(%v, %v) = %v, want q applied first", p, q, pq)
+			}
+		}
+		back, _ := random.InversePerm(inv)
+		for j := range back {
+			if back[j] != p[j] {
+				t.Fatalf("the inverse of the inverse of %v is %v", p, back)
+			}
+		}
+	}
+	if _, err := random.InversePerm([]int{0, 0}); !errors.Is(err, random.ErrInvalid) {
+		t.Errorf("InversePerm([0 0]) error = %v, want ErrInvalid", err)
+	}
+}
@@ -0,0 +1,210 @@
+/*
+ * File: check.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package prop
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anonyindian/random-go"
+)
+
+// SeedEnv is the environment variable which sets the seed of Check, to replay a failing run.
+const SeedEnv = "PROP_SEED"
+
+// Config controls a run of CheckConfig.
+type Config struct {
+	Iterations int   // the number of random inputs checked, 100 if it is 0
+	MaxSize    int   // the size of the last inputs, sizes grow from 0 up to MaxSize, 100 if it is 0
+	MaxShrinks int   // the number of successful shrink steps, 1000 if it is 0
+	Seed       int64 // the seed of the inputs, 0 uses $PROP_SEED or else the current time
+}
+
+// errorType is the reflect.Type of error.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// Check function is used to check that property holds for random inputs, with the default Config.
+// property is a function taking one argument per generator of gens, with matching types, and returning a bool
+// or an error, false and a non-nil error mean the property failed, and so does a panic.
+// On failure the inputs are shrunk to a minimal counterexample, which is reported with t.Fatalf along with the seed,
+// so the run can be replayed by setting $PROP_SEED or Config.Seed.
+// example: prop.Check(t, func(a, b int) bool { return a+b == b+a }, prop.Int(), prop.Int()), checks that addition commutes.
+func Check(t testing.TB, property interface{}, gens ...Generator) {
+	t.Helper()
+	CheckConfig(t, Config{}, property, gens...)
+}
+
+// CheckConfig function is used to check that property holds for random inputs, like Check with the settings of c.
+func CheckConfig(t testing.TB, c Config, property interface{}, gens ...Generator) {
+	t.Helper()
+	p := reflect.ValueOf(property)
+	if err := checkProperty(p.Type(), gens); err != nil {
+		t.Fatalf("prop: %v", err)
+	}
+	if c.Iterations == 0 {
+		c.Iterations = 100
+	}
+	if c.MaxSize == 0 {
+		c.MaxSize = 100
+	}
+	if c.MaxShrinks == 0 {
+		c.MaxShrinks = 1000
+	}
+	if c.Seed == 0 {
+		c.Seed = time.Now().UnixNano()
+		if s := os.Getenv(SeedEnv); s != "" {
+			seed, err := strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				t.Fatalf("prop: invalid $%s: %v", SeedEnv, err)
+			}
+			c.Seed = seed
+		}
+	}
+	r := random.NewRand(c.Seed)
+	for i := 0; i < c.Iterations; i++ {
+		size := 0
+		if c.Iterations > 1 {
+			size = c.MaxSize * i / (c.Iterations - 1)
+		}
+		args := make([]interface{}, len(gens))
+		for j, g := range gens {
+			args[j] = g.GenerateValue(r, size)
+		}
+		in, err := arguments(p, args)
+		if err != nil {
+			t.Fatalf("prop: %v", err)
+		}
+		err = call(p, in)
+		if err == nil {
+			continue
+		}
+		original := args
+		args, shrinks, err := shrink(p, gens, args, err, c.MaxShrinks)
+		t.Fatalf("prop: property failed after %d tests and %d shrinks (seed %d, rerun with %s=%d)\ncounterexample: %s\noriginal:       %s\nfailure: %v",
+			i+1, shrinks, c.Seed, SeedEnv, c.Seed, formatArgs(args), formatArgs(original), err)
+	}
+}
+
+// checkProperty is one of the inner functions of this package.
+// It checks that a property of type typ can be called with the values of gens.
+func checkProperty(typ reflect.Type, gens []Generator) error {
+	if typ.Kind() != reflect.Func {
+		return fmt.Errorf("property of type %s is not a function", typ)
+	}
+	if typ.NumOut() != 1 || (typ.Out(0).Kind() != reflect.Bool && typ.Out(0) != errorType) {
+		return fmt.Errorf("property of type %s must return a bool or an error", typ)
+	}
+	if typ.NumIn() != len(gens) || typ.IsVariadic() {
+		return fmt.Errorf("property of type %s takes %d arguments but %d generators were given", typ, typ.NumIn(), len(gens))
+	}
+	// A probe value tells the type of each generator, like Struct does for its fields.
+	for i, g := range gens {
+		if gt := reflect.TypeOf(g.GenerateValue(rand.New(rand.NewSource(0)), 0)); gt != nil && !gt.AssignableTo(typ.In(i)) {
+			return fmt.Errorf("generator %d of type %s doesn't fit the property parameter of type %s", i, gt, typ.In(i))
+		}
+	}
+	return nil
+}
+
+// arguments is one of the inner functions of this package.
+// It converts args to the arguments of the property p, or returns an error if one of them doesn't fit its parameter.
+func arguments(p reflect.Value, args []interface{}) ([]reflect.Value, error) {
+	in := make([]reflect.Value, len(args))
+	for i, a := range args {
+		if a == nil {
+			in[i] = reflect.Zero(p.Type().In(i))
+			continue
+		}
+		in[i] = reflect.ValueOf(a)
+		if !in[i].Type().AssignableTo(p.Type().In(i)) {
+			return nil, fmt.Errorf("argument %d of type %s doesn't fit the property parameter of type %s", i, in[i].Type(), p.Type().In(i))
+		}
+	}
+	return in, nil
+}
+
+// call is one of the inner functions of this package.
+// It calls the property p with the arguments in and returns why it failed, or nil if it held.
+func call(p reflect.Value, in []reflect.Value) (err error) {
+	defer func() {
+		if x := recover(); x != nil {
+			err = fmt.Errorf("panic: %v", x)
+		}
+	}()
+	out := p.Call(in)[0]
+	if out.Kind() == reflect.Bool {
+		if !out.Bool() {
+			return fmt.Errorf("returned false")
+		}
+		return nil
+	}
+	if !out.IsNil() {
+		return out.Interface().(error)
+	}
+	return nil
+}
+
+// shrink is one of the inner functions of this package.
+// It greedily replaces the failing args with simpler ones which still fail, one argument at a time, until none is found
+// or maxShrinks steps were taken. It returns the simplest args, the number of steps and their failure.
+func shrink(p reflect.Value, gens []Generator, args []interface{}, err error, maxShrinks int) ([]interface{}, int, error) {
+	steps := 0
+	for steps < maxShrinks {
+		found := false
+		for i := 0; i < len(args) && !found; i++ {
+			for _, c := range gens[i].ShrinkValue(args[i]) {
+				candidate := append([]interface{}(nil), args...)
+				candidate[i] = c
+				// A candidate which doesn't fit the property is a bug of the shrinker, not a simpler failure.
+				in, aerr := arguments(p, candidate)
+				if aerr != nil {
+					continue
+				}
+				if cerr := call(p, in); cerr != nil {
+					args, err, found = candidate, cerr, true
+					steps++
+					break
+				}
+			}
+		}
+		if !found {
+			break
+		}
+	}
+	return args, steps, err
+}
+
+// formatArgs is one of the inner functions of this package.
+// It formats the arguments of a property for a failure message.
+func formatArgs(args []interface{}) string {
+	s := make([]string, len(args))
+	for i, a := range args {
+		s[i] = fmt.Sprintf("%#v", a)
+	}
+	return strings.Join(s, ", ")
+}
@@ -0,0 +1,373 @@
+/*
+ * File: gen.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+// Package prop runs property-based tests: a property is checked against many random inputs and
+// a failing input is shrunk to a minimal counterexample, in the spirit of QuickCheck.
+// Inputs come from composable generators built on the random package, every run is reproducible from its seed.
+package prop
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"reflect"
+
+	"github.com/anonyindian/random-go"
+)
+
+// Generator is the type-erased form of Gen, which Check uses to drive properties with arguments of different types.
+type Generator interface {
+	GenerateValue(r *rand.Rand, size int) interface{} // a random value, size bounds the magnitude of numbers and the length of collections
+	ShrinkValue(v interface{}) []interface{}          // smaller values derived from v, which must be of the generated type
+}
+
+// Gen generates random values of type T and shrinks them towards simpler values.
+// It implements Generator.
+type Gen[T any] struct {
+	generate func(r *rand.Rand, size int) T
+	shrink   func(v T) []T
+}
+
+// New function is used to get a Gen from a generate function and a shrink function, which may be nil.
+// shrink returns candidates simpler than v, the simplest first, and nothing once v can't be simplified.
+// example: prop.New(func(r *rand.Rand, size int) Point { return Point{r.Intn(size + 1), r.Intn(size + 1)} }, nil), returns a generator of points.
+func New[T any](generate func(r *rand.Rand, size int) T, shrink func(v T) []T) Gen[T] {
+	return Gen[T]{generate, shrink}
+}
+
+// Generate returns a random value, size bounds the magnitude of numbers and the length of collections.
+func (g Gen[T]) Generate(r *rand.Rand, size int) T {
+	return g.generate(r, size)
+}
+
+// Shrink returns values simpler than v, the simplest first.
+func (g Gen[T]) Shrink(v T) []T {
+	if g.shrink == nil {
+		return nil
+	}
+	return g.shrink(v)
+}
+
+// GenerateValue returns Generate(r, size) as an interface{}.
+func (g Gen[T]) GenerateValue(r *rand.Rand, size int) interface{} {
+	return g.Generate(r, size)
+}
+
+// ShrinkValue returns Shrink(v.(T)) as a slice of interface{}.
+func (g Gen[T]) ShrinkValue(v interface{}) []interface{} {
+	var x T
+	if v != nil {
+		x = v.(T)
+	}
+	s := g.Shrink(x)
+	out := make([]interface{}, len(s))
+	for i := range s {
+		out[i] = s[i]
+	}
+	return out
+}
+
+// Int function returns a generator of integers from the range [-size, size], which shrink towards 0.
+func Int() Gen[int] {
+	return New(func(r *rand.Rand, size int) int {
+		return (&random.UniformInteger{StartNum: -size, EndNum: size, Rand: r}).Sample()
+	}, shrinkInt)
+}
+
+// IntRange function returns a generator of integers from the range [startNum, endNum], which shrink towards
+// the value of the range closest to 0. The range may span all of int, it panics if startNum is greater than endNum.
+// example: prop.IntRange(1, 6), returns a generator rolling a dice of 6 faces.
+func IntRange(startNum int, endNum int) Gen[int] {
+	if startNum > endNum {
+		panic(fmt.Sprintf("prop: IntRange with startNum %d greater than endNum %d", startNum, endNum))
+	}
+	target := 0
+	if startNum > 0 {
+		target = startNum
+	} else if endNum < 0 {
+		target = endNum
+	}
+	return New(func(r *rand.Rand, size int) int {
+		return (&random.UniformInteger{StartNum: startNum, EndNum: endNum, Rand: r}).Sample()
+	}, func(v int) []int {
+		var out []int
+		for _, c := range shrinkInt(v - target) {
+			// shrinkInt tries -x for negative x, which may leave the range.
+			if c += target; c >= startNum && c <= endNum {
+				out = append(out, c)
+			}
+		}
+		return out
+	})
+}
+
+// Float64 function returns a generator of normally distributed floats with a standard deviation of size,
+// which shrink towards 0 and integers.
+func Float64() Gen[float64] {
+	return New(func(r *rand.Rand, size int) float64 {
+		return r.NormFloat64() * float64(size)
+	}, shrinkFloat)
+}
+
+// FloatRange function returns a generator of floats from the range [startNum, endNum), which shrink towards startNum.
+func FloatRange(startNum float64, endNum float64) Gen[float64] {
+	return New(func(r *rand.Rand, size int) float64 {
+		return startNum + r.Float64()*(endNum-startNum)
+	}, func(v float64) []float64 {
+		var out []float64
+		for _, c := range shrinkFloat(v - startNum) {
+			if c >= 0 {
+				out = append(out, c+startNum)
+			}
+		}
+		return out
+	})
+}
+
+// Bool function returns a generator of bools, true shrinks to false.
+func Bool() Gen[bool] {
+	return New(func(r *rand.Rand, size int) bool {
+		return r.Intn(2) == 1
+	}, func(v bool) []bool {
+		if v {
+			return []bool{false}
+		}
+		return nil
+	})
+}
+
+// String function returns a generator of alphanumeric strings of up to size runes.
+func String() Gen[string] {
+	return StringOf(random.AlphabetAlnum)
+}
+
+// StringOf function returns a generator of strings of up to size runes drawn from alphabet.
+// The strings shrink by dropping runes and by replacing runes with the first rune of alphabet.
+// example: prop.StringOf(random.UnicodeTables(unicode.Greek)), returns a generator of greek strings.
+func StringOf(alphabet random.Alphabet) Gen[string] {
+	runes := SliceOf(New(func(r *rand.Rand, size int) rune {
+		return alphabet[r.Intn(len(alphabet))]
+	}, func(v rune) []rune {
+		if v == alphabet[0] {
+			return nil
+		}
+		return []rune{alphabet[0]}
+	}))
+	return New(func(r *rand.Rand, size int) string {
+		return (&random.UniformString{Alphabet: alphabet, MaxLength: size, Rand: r}).Sample()
+	}, func(v string) []string {
+		var out []string
+		for _, c := range runes.Shrink([]rune(v)) {
+			out = append(out, string(c))
+		}
+		return out
+	})
+}
+
+// OneOf function returns a generator choosing among values, which shrink towards the first values.
+// example: prop.OneOf("GET", "POST", "DELETE"), returns a generator of HTTP methods.
+func OneOf[T any](values ...T) Gen[T] {
+	return New(func(r *rand.Rand, size int) T {
+		return values[r.Intn(len(values))]
+	}, func(v T) []T {
+		for i := range values {
+			if reflect.DeepEqual(values[i], v) {
+				return values[:i]
+			}
+		}
+		return nil
+	})
+}
+
+// SliceOf function returns a generator of slices of up to size elements generated by g.
+// The slices shrink by dropping elements, then by shrinking their elements.
+func SliceOf[T any](g Gen[T]) Gen[[]T] {
+	return New(func(r *rand.Rand, size int) []T {
+		s := make([]T, r.Intn(size+1))
+		for i := range s {
+			s[i] = g.Generate(r, size)
+		}
+		return s
+	}, func(v []T) [][]T {
+		if len(v) == 0 {
+			return nil
+		}
+		out := [][]T{{}}
+		for k := len(v) / 2; k > 0; k /= 2 {
+			for i := 0; i+k <= len(v); i += k {
+				c := make([]T, 0, len(v)-k)
+				out = append(out, append(append(c, v[:i]...), v[i+k:]...))
+			}
+		}
+		for i := range v {
+			for _, e := range g.Shrink(v[i]) {
+				c := append([]T(nil), v...)
+				c[i] = e
+				out = append(out, c)
+			}
+		}
+		return out
+	})
+}
+
+// MapOf function returns a generator of maps of up to size entries, whose keys and values are generated by keys and values.
+// The maps shrink by dropping entries, then by shrinking their values.
+func MapOf[K comparable, V any](keys Gen[K], values Gen[V]) Gen[map[K]V] {
+	return New(func(r *rand.Rand, size int) map[K]V {
+		n := r.Intn(size + 1)
+		m := make(map[K]V, n)
+		for i := 0; i < n; i++ {
+			m[keys.Generate(r, size)] = values.Generate(r, size)
+		}
+		return m
+	}, func(v map[K]V) []map[K]V {
+		if len(v) == 0 {
+			return nil
+		}
+		out := []map[K]V{{}}
+		for k := range v {
+			c := copyMap(v)
+			delete(c, k)
+			out = append(out, c)
+		}
+		for k := range v {
+			for _, e := range values.Shrink(v[k]) {
+				c := copyMap(v)
+				c[k] = e
+				out = append(out, c)
+			}
+		}
+		return out
+	})
+}
+
+// Map function returns a generator applying f to the values of g.
+// The values of the result can't be traced back to the values of g, so they don't shrink.
+// example: prop.Map(prop.IntRange(0, 23), func(h int) time.Duration { return time.Duration(h) * time.Hour }), returns a generator of whole hours.
+func Map[T, U any](g Gen[T], f func(T) U) Gen[U] {
+	return New(func(r *rand.Rand, size int) U {
+		return f(g.Generate(r, size))
+	}, nil)
+}
+
+// Struct function returns a generator of structs of type T, whose fields are generated by the generators in fields,
+// keyed by field name. Fields without a generator keep their zero value. The structs shrink one field at a time.
+// It panics if T isn't a struct, or if a field doesn't exist, isn't exported or doesn't match its generator.
+// example: prop.Struct[User](map[string]prop.Generator{"Name": prop.String(), "Age": prop.IntRange(0, 120)}), returns a generator of users.
+func Struct[T any](fields map[string]Generator) Gen[T] {
+	typ := reflect.TypeOf((*T)(nil)).Elem()
+	if typ.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("prop: Struct of non-struct type %s", typ))
+	}
+	for name, g := range fields {
+		f, ok := typ.FieldByName(name)
+		if !ok || f.PkgPath != "" {
+			panic(fmt.Sprintf("prop: %s has no exported field %s", typ, name))
+		}
+		if gt := reflect.TypeOf(g.GenerateValue(rand.New(rand.NewSource(0)), 0)); gt != nil && !gt.AssignableTo(f.Type) {
+			panic(fmt.Sprintf("prop: generator of %s for field %s.%s of type %s", gt, typ, name, f.Type))
+		}
+	}
+	return New(func(r *rand.Rand, size int) T {
+		var x T
+		v := reflect.ValueOf(&x).Elem()
+		// Fields are generated in field order, so that a seed always gives the same struct.
+		for i := 0; i < typ.NumField(); i++ {
+			if g, ok := fields[typ.Field(i).Name]; ok {
+				setField(v.Field(i), g.GenerateValue(r, size))
+			}
+		}
+		return x
+	}, func(x T) []T {
+		var out []T
+		v := reflect.ValueOf(x)
+		for i := 0; i < typ.NumField(); i++ {
+			g, ok := fields[typ.Field(i).Name]
+			if !ok {
+				continue
+			}
+			for _, e := range g.ShrinkValue(v.Field(i).Interface()) {
+				c := x
+				setField(reflect.ValueOf(&c).Elem().Field(i), e)
+				out = append(out, c)
+			}
+		}
+		return out
+	})
+}
+
+// setField is one of the inner functions of this package.
+// It sets the field f to x, a nil x sets the zero value.
+func setField(f reflect.Value, x interface{}) {
+	if x == nil {
+		f.Set(reflect.Zero(f.Type()))
+		return
+	}
+	f.Set(reflect.ValueOf(x))
+}
+
+// shrinkInt is one of the inner functions of this package.
+// It returns 0, -x if x is negative, then values closer and closer to x.
+func shrinkInt(x int) []int {
+	if x == 0 {
+		return nil
+	}
+	out := []int{0}
+	if x < 0 && -x > 0 {
+		out = append(out, -x)
+	}
+	for d := x / 2; d != 0; d /= 2 {
+		if c := x - d; c != 0 {
+			out = append(out, c)
+		}
+	}
+	return out
+}
+
+// shrinkFloat is one of the inner functions of this package.
+// It returns 0, -x if x is negative, the integer part of x and half of x.
+func shrinkFloat(x float64) []float64 {
+	if x == 0 || math.IsNaN(x) {
+		return nil
+	}
+	out := []float64{0}
+	if x < 0 {
+		out = append(out, -x)
+	}
+	if t := math.Trunc(x); t != x && !math.IsInf(x, 0) {
+		out = append(out, t)
+	}
+	if h := x / 2; h != x && h != 0 {
+		out = append(out, h)
+	}
+	return out
+}
+
+// copyMap is one of the inner functions of this package.
+// It returns a shallow copy of m.
+func copyMap[K comparable, V any](m map[K]V) map[K]V {
+	c := make(map[K]V, len(m))
+	for k, v := range m {
+		c[k] = v
+	}
+	return c
+}
@@ -0,0 +1,97 @@
+/*
+ * File: prop_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package prop_test
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/anonyindian/random-go"
+	"github.com/anonyindian/random-go/prop"
+)
+
+// fatalTB records the message of Fatalf and stops the check with a panic, so that failures can be tested.
+type fatalTB struct {
+	testing.TB
+	msg string
+}
+
+func (f *fatalTB) Helper() {}
+
+func (f *fatalTB) Fatalf(format string, args ...interface{}) {
+	f.msg = fmt.Sprintf(format, args...)
+	panic(f)
+}
+
+// check runs prop.CheckConfig on a fatalTB and returns the failure message, empty if the property held.
+func check(c prop.Config, property interface{}, gens ...prop.Generator) (msg string) {
+	tb := &fatalTB{}
+	defer func() {
+		if x := recover(); x != nil && x != tb {
+			panic(x)
+		}
+		msg = tb.msg
+	}()
+	prop.CheckConfig(tb, c, property, gens...)
+	return ""
+}
+
+func TestIntRangeShrink(t *testing.T) {
+	r := random.NewRand(1)
+	for _, c := range [][2]int{{-10, 5}, {-5, 10}, {3, 9}, {-9, -3}, {0, 0}, {math.MinInt, math.MaxInt}, {math.MinInt, -1}} {
+		g := prop.IntRange(c[0], c[1])
+		for i := 0; i < 200; i++ {
+			v := g.Generate(r, 100)
+			if v < c[0] || v > c[1] {
+				t.Fatalf("IntRange(%d, %d) generated %d", c[0], c[1], v)
+			}
+			for _, s := range g.Shrink(v) {
+				if s < c[0] || s > c[1] {
+					t.Fatalf("IntRange(%d, %d) shrank %d to %d", c[0], c[1], v, s)
+				}
+			}
+		}
+	}
+}
+
+func TestCheckShrinks(t *testing.T) {
+	msg := check(prop.Config{Seed: 1}, func(x int) bool { return x > -3 }, prop.IntRange(-10, 5))
+	if !strings.Contains(msg, "counterexample: -3\n") {
+		t.Fatalf("got failure %q, want the counterexample -3", msg)
+	}
+}
+
+func TestCheckGeneratorMismatch(t *testing.T) {
+	called := false
+	msg := check(prop.Config{Seed: 1}, func(s string) bool {
+		called = true
+		return true
+	}, prop.Int())
+	if !strings.Contains(msg, "doesn't fit") || strings.Contains(msg, "counterexample") {
+		t.Fatalf("got failure %q, want a usage error", msg)
+	}
+	if called {
+		t.Fatal("the property was called with a generator of the wrong type")
+	}
+}
@@ -0,0 +1,78 @@
+/*
+ * File: quick.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package prop
+
+import (
+	"math/rand"
+	"reflect"
+
+	"github.com/anonyindian/random-go"
+)
+
+// QuickSize is the size passed to the generators driven by testing/quick, which has no notion of growing sizes.
+const QuickSize = 50
+
+// Values function is used to drive a testing/quick check with gens, one generator per argument of the checked function.
+// The result fits quick.Config.Values.
+// example: quick.Check(f, &quick.Config{Values: prop.Values(prop.IntRange(1, 6), prop.String())}), checks f with dice rolls and strings.
+func Values(gens ...Generator) func(args []reflect.Value, r *rand.Rand) {
+	return func(args []reflect.Value, r *rand.Rand) {
+		for i := range args {
+			if x := gens[i].GenerateValue(r, QuickSize); x != nil {
+				args[i] = reflect.ValueOf(x)
+			} else {
+				args[i] = reflect.Zero(args[i].Type())
+			}
+		}
+	}
+}
+
+// Provider gives the generator of a Quick value, it is usually an empty struct type.
+type Provider[T any] interface {
+	Gen() Gen[T]
+}
+
+// Quick holds a value generated by the Gen of P. It implements quick.Generator, so that any generator can be used
+// as the type of an argument of a function checked by testing/quick.
+// example:
+//
+//	type port struct{}
+//
+//	func (port) Gen() prop.Gen[int] { return prop.IntRange(1, 65535) }
+//
+//	quick.Check(func(p prop.Quick[int, port]) bool { return dial(p.Value) == nil }, nil)
+type Quick[T any, P Provider[T]] struct {
+	Value T
+}
+
+// Generate returns a Quick holding a value of the Gen of P, for testing/quick.
+func (Quick[T, P]) Generate(r *rand.Rand, size int) reflect.Value {
+	var p P
+	return reflect.ValueOf(Quick[T, P]{p.Gen().Generate(r, size)})
+}
+
+// FromBytes function is used to generate a value of g from data, typically the []byte argument of a fuzz target,
+// so that a fuzzer explores structured values. The same data always gives the same value, see random.NewFuzz.
+// example: users := prop.FromBytes(prop.SliceOf(userGen), data, 20), returns up to 20 users decoded from the fuzz input.
+func FromBytes[T any](g Gen[T], data []byte, size int) T {
+	return g.Generate(random.NewFuzz(data).Rand(), size)
+}
@@ -0,0 +1,380 @@
+/*
+ * File: quasi.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"math/rand"
+)
+
+// QuasiRandom is implemented by the low-discrepancy sequences of this package: Sobol, Halton and R.
+// Their points fill [0,1)^d much more evenly than the points of Float64N, which makes them
+// suitable for numerical integration and parameter sweeps.
+type QuasiRandom interface {
+	Next() []float64 // returns the next point of the sequence, a new slice of length Dim()
+	Dim() int        // returns the number of dimensions of each point
+}
+
+// sobolDirections holds the degree s, the polynomial coefficients a and the initial direction numbers m
+// of the Sobol dimensions 2 to 21, taken from the new-joe-kuo-6.21201 table by S. Joe and F. Y. Kuo.
+var sobolDirections = []struct {
+	s, a uint32
+	m    []uint32
+}{
+	{1, 0, []uint32{1}},
+	{2, 1, []uint32{1, 3}},
+	{3, 1, []uint32{1, 3, 1}},
+	{3, 2, []uint32{1, 1, 1}},
+	{4, 1, []uint32{1, 1, 3, 3}},
+	{4, 4, []uint32{1, 3, 5, 13}},
+	{5, 2, []uint32{1, 1, 5, 5, 17}},
+	{5, 4, []uint32{1, 1, 5, 5, 5}},
+	{5, 7, []uint32{1, 1, 7, 11, 19}},
+	{5, 11, []uint32{1, 1, 5, 1, 1}},
+	{5, 13, []uint32{1, 1, 1, 3, 11}},
+	{5, 14, []uint32{1, 3, 5, 5, 31}},
+	{6, 1, []uint32{1, 3, 3, 9, 7, 49}},
+	{6, 13, []uint32{1, 1, 1, 15, 21, 21}},
+	{6, 16, []uint32{1, 3, 1, 13, 27, 49}},
+	{6, 19, []uint32{1, 1, 1, 15, 7, 5}},
+	{6, 22, []uint32{1, 3, 1, 15, 13, 25}},
+	{6, 25, []uint32{1, 1, 5, 5, 19, 61}},
+	{7, 1, []uint32{1, 3, 7, 11, 23, 15, 103}},
+	{7, 4, []uint32{1, 3, 7, 13, 13, 15, 69}},
+}
+
+// MaxSobolDim is the highest number of dimensions supported by Sobol.
+const MaxSobolDim = 21
+
+// Sobol is the Sobol low-discrepancy sequence, generated in gray code order.
+// The sequence has a period of 2^32 points, after which it starts over from the first point.
+type Sobol struct {
+	v     [][32]uint32 // direction numbers of each dimension
+	x     []uint32     // the current point as 32 bit fractions
+	shift []uint32     // the digital shift applied to each point, zero unless scrambled
+	n     uint32       // the index of the next point
+}
+
+// NewSobol function is used to get a Sobol sequence of points in [0,1)^dim.
+// dim (type int) must be in the range [1, MaxSobolDim].
+// It returns the sequence of type *Sobol and any write error encountered.
+// example: random.NewSobol(2), returns a sequence of 2-dimensional points starting with (0, 0), (0.5, 0.5), (0.75, 0.25).
+func NewSobol(dim int) (*Sobol, error) {
+	const fn = "NewSobol"
+	if dim < 1 || dim > MaxSobolDim {
+		return nil, &Error{fn, fmt.Errorf("%w: dim must be in the range [1, %d]", ErrInvalid, MaxSobolDim)}
+	}
+	s := &Sobol{v: make([][32]uint32, dim), x: make([]uint32, dim), shift: make([]uint32, dim)}
+	for k := 0; k < 32; k++ {
+		s.v[0][k] = 1 << (31 - k)
+	}
+	for j := 1; j < dim; j++ {
+		d := sobolDirections[j-1]
+		v := &s.v[j]
+		for k := uint32(0); k < 32; k++ {
+			if k < d.s {
+				v[k] = d.m[k] << (31 - k)
+				continue
+			}
+			v[k] = v[k-d.s] ^ (v[k-d.s] >> d.s)
+			for l := uint32(1); l < d.s; l++ {
+				if (d.a>>(d.s-1-l))&1 == 1 {
+					v[k] ^= v[k-l]
+				}
+			}
+		}
+	}
+	return s, nil
+}
+
+// NewScrambledSobol function is used to get a randomly scrambled Sobol sequence of points in [0,1)^dim.
+// The direction numbers are scrambled with a random linear matrix and every point is digitally shifted,
+// which keeps the low discrepancy while removing the point at the origin and making error estimates possible.
+// r (type *rand.Rand) is the generator used for scrambling, nil uses Default().
+// It returns the sequence of type *Sobol and any write error encountered.
+func NewScrambledSobol(dim int, r *rand.Rand) (*Sobol, error) {
+	s, err := NewSobol(dim)
+	if err != nil {
+		return nil, err
+	}
+	r = rng(r)
+	for j := range s.v {
+		var rows [32]uint32
+		for i := range rows {
+			rows[i] = 1 << (31 - i)
+			if i > 0 {
+				rows[i] |= r.Uint32() &^ (1<<(32-i) - 1)
+			}
+		}
+		for k, v := range s.v[j] {
+			var y uint32
+			for i, row := range rows {
+				y |= uint32(bits.OnesCount32(row&v)&1) << (31 - i)
+			}
+			s.v[j][k] = y
+		}
+		s.shift[j] = r.Uint32()
+	}
+	return s, nil
+}
+
+// Next function returns the next point of the sequence.
+func (s *Sobol) Next() []float64 {
+	p := make([]float64, len(s.x))
+	for j, x := range s.x {
+		p[j] = float64(x^s.shift[j]) / (1 << 32)
+	}
+	c := bits.TrailingZeros32(^s.n)
+	if c == 32 {
+		// The last point of the period, its gray code is 1<<31 so flipping v[31] wraps the sequence to index 0.
+		c = 31
+	}
+	for j := range s.x {
+		s.x[j] ^= s.v[j][c]
+	}
+	s.n++
+	return p
+}
+
+// Dim function returns the number of dimensions of the sequence.
+func (s *Sobol) Dim() int {
+	return len(s.x)
+}
+
+// Halton is the Halton low-discrepancy sequence, dimension j uses the radical inverse in the j-th prime base.
+// It works best for a small number of dimensions, use the scrambled variant for more than a few.
+type Halton struct {
+	bases []int
+	perms [][][]int // digit permutations per dimension and digit position, nil unless scrambled
+	n     uint64
+}
+
+// NewHalton function is used to get a Halton sequence of points in [0,1)^dim.
+// It returns the sequence of type *Halton and any write error encountered.
+// example: random.NewHalton(2), returns a sequence of 2-dimensional points starting with (0, 0), (0.5, 0.333), (0.25, 0.667).
+func NewHalton(dim int) (*Halton, error) {
+	const fn = "NewHalton"
+	if dim < 1 {
+		return nil, &Error{fn, fmt.Errorf("%w: dim must be positive", ErrInvalid)}
+	}
+	return &Halton{bases: primes(dim)}, nil
+}
+
+// NewScrambledHalton function is used to get a Halton sequence of points in [0,1)^dim with randomly permuted digits.
+// Each digit position of each dimension gets its own random permutation,
+// which breaks the correlation between the dimensions of high prime bases.
+// r (type *rand.Rand) is the generator used for scrambling, nil uses Default().
+// It returns the sequence of type *Halton and any write error encountered.
+func NewScrambledHalton(dim int, r *rand.Rand) (*Halton, error) {
+	h, err := NewHalton(dim)
+	if err != nil {
+		return nil, err
+	}
+	r = rng(r)
+	h.perms = make([][][]int, dim)
+	for j, b := range h.bases {
+		digits := int(math.Ceil(53 * math.Ln2 / math.Log(float64(b))))
+		h.perms[j] = make([][]int, digits)
+		for k := range h.perms[j] {
+			h.perms[j][k] = r.Perm(b)
+		}
+	}
+	return h, nil
+}
+
+// Next function returns the next point of the sequence.
+func (h *Halton) Next() []float64 {
+	p := make([]float64, len(h.bases))
+	for j, b := range h.bases {
+		f, inv := 0.0, 1/float64(b)
+		i := h.n
+		if h.perms == nil {
+			for ; i > 0; i /= uint64(b) {
+				f += float64(i%uint64(b)) * inv
+				inv /= float64(b)
+			}
+		} else {
+			for _, perm := range h.perms[j] {
+				f += float64(perm[i%uint64(b)]) * inv
+				inv /= float64(b)
+				i /= uint64(b)
+			}
+		}
+		p[j] = math.Min(f, math.Nextafter(1, 0))
+	}
+	h.n++
+	return p
+}
+
+// Dim function returns the number of dimensions of the sequence.
+func (h *Halton) Dim() int {
+	return len(h.bases)
+}
+
+// R is the additive recurrence R-sequence by Martin Roberts, also known as R2 in two dimensions.
+// Point n is frac(offset + n*alpha) where alpha is derived from the generalised golden ratio of the dimension.
+type R struct {
+	alpha  []float64
+	offset []float64
+	n      uint64
+}
+
+// NewR function is used to get an R-sequence of points in [0,1)^dim.
+// It returns the sequence of type *R and any write error encountered.
+// example: random.NewR(2), returns the R2 sequence.
+func NewR(dim int) (*R, error) {
+	const fn = "NewR"
+	if dim < 1 {
+		return nil, &Error{fn, fmt.Errorf("%w: dim must be positive", ErrInvalid)}
+	}
+	// phi is the unique positive root of x^(dim+1) = x + 1, found by fixed point iteration.
+	phi := 2.0
+	for i := 0; i < 64; i++ {
+		phi = math.Pow(1+phi, 1/float64(dim+1))
+	}
+	s := &R{alpha: make([]float64, dim), offset: make([]float64, dim)}
+	for j := range s.alpha {
+		s.alpha[j] = math.Mod(1/math.Pow(phi, float64(j+1)), 1)
+		s.offset[j] = 0.5
+	}
+	return s, nil
+}
+
+// NewScrambledR function is used to get an R-sequence of points in [0,1)^dim with a random offset in each dimension.
+// r (type *rand.Rand) is the generator used for the offsets, nil uses Default().
+// It returns the sequence of type *R and any write error encountered.
+func NewScrambledR(dim int, r *rand.Rand) (*R, error) {
+	s, err := NewR(dim)
+	if err != nil {
+		return nil, err
+	}
+	r = rng(r)
+	for j := range s.offset {
+		s.offset[j] = r.Float64()
+	}
+	return s, nil
+}
+
+// Next function returns the next point of the sequence.
+func (s *R) Next() []float64 {
+	p := make([]float64, len(s.alpha))
+	for j := range p {
+		_, f := math.Modf(s.offset[j] + float64(s.n)*s.alpha[j])
+		p[j] = f
+	}
+	s.n++
+	return p
+}
+
+// Dim function returns the number of dimensions of the sequence.
+func (s *R) Dim() int {
+	return len(s.alpha)
+}
+
+// QuasiN function is used to get the next n points of a QuasiRandom sequence.
+// It returns the points in a slice of type [][]float64.
+// example: random.QuasiN(sobol, 8), returns the next 8 points of sobol.
+func QuasiN(q QuasiRandom, n int) [][]float64 {
+	p := make([][]float64, n)
+	for i := range p {
+		p[i] = q.Next()
+	}
+	return p
+}
+
+// QuasiRange function is used to get the next n points of a QuasiRandom sequence scaled to the given ranges.
+// Dimension j of each point is scaled to the range [startNums[j], endNums[j]), like Float64N does for pseudo-random values.
+// startNums and endNums (type []float64) must both have q.Dim() elements.
+// It returns the scaled points in a slice of type [][]float64 and any write error encountered.
+// example: random.QuasiRange(halton, []float64{0, 10}, []float64{1, 20}, 100), returns 100 points covering [0, 1) x [10, 20) evenly.
+func QuasiRange(q QuasiRandom, startNums []float64, endNums []float64, n int) ([][]float64, error) {
+	const fn = "QuasiRange"
+	if len(startNums) != q.Dim() || len(endNums) != q.Dim() {
+		return nil, &Error{fn, fmt.Errorf("%w: need %d ranges", ErrInvalid, q.Dim())}
+	}
+	if err := checkRanges(startNums, endNums); err != nil {
+		return nil, &Error{fn, err}
+	}
+	p := QuasiN(q, n)
+	for _, x := range p {
+		for j := range x {
+			x[j] = startNums[j] + x[j]*(endNums[j]-startNums[j])
+		}
+	}
+	return p, nil
+}
+
+// Discrepancy function returns the L2-star discrepancy of points in [0,1)^d, computed with Warnock's formula.
+// Lower values mean the points cover the unit cube more evenly, which makes it useful to compare sequences.
+// All the points must have the same number of dimensions. It takes O(n^2 * d) time.
+// example: random.Discrepancy(random.QuasiN(sobol, 256)), returns a much lower value than for 256 points of Float64N.
+func Discrepancy(points [][]float64) float64 {
+	if len(points) == 0 {
+		return 0
+	}
+	n, d := float64(len(points)), len(points[0])
+	sum1, sum2 := 0.0, 0.0
+	for i, x := range points {
+		p := 1.0
+		for k := 0; k < d; k++ {
+			p *= (1 - x[k]*x[k]) / 2
+		}
+		sum1 += p
+		for _, y := range points[i+1:] {
+			p := 1.0
+			for k := 0; k < d; k++ {
+				p *= 1 - math.Max(x[k], y[k])
+			}
+			sum2 += 2 * p
+		}
+		p = 1.0
+		for k := 0; k < d; k++ {
+			p *= 1 - x[k]
+		}
+		sum2 += p
+	}
+	return math.Sqrt(math.Max(0, math.Pow(3, -float64(d))-2/n*sum1+sum2/(n*n)))
+}
+
+// primes is one of the inner functions of this package.
+// It returns the first n prime numbers.
+func primes(n int) []int {
+	p := make([]int, 0, n)
+	for c := 2; len(p) < n; c++ {
+		prime := true
+		for _, q := range p {
+			if q*q > c {
+				break
+			}
+			if c%q == 0 {
+				prime = false
+				break
+			}
+		}
+		if prime {
+			p = append(p, c)
+		}
+	}
+	return p
+}
@@ -0,0 +1,110 @@
+/*
+ * File: quasi_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestSobolJoeKuo(t *testing.T) {
+	// The first points of the unscrambled Sobol sequence with the new-joe-kuo-6.21201 direction numbers.
+	want := [][]float64{
+		{0, 0, 0},
+		{0.5, 0.5, 0.5},
+		{0.75, 0.25, 0.25},
+		{0.25, 0.75, 0.75},
+		{0.375, 0.375, 0.625},
+		{0.875, 0.875, 0.125},
+		{0.625, 0.125, 0.875},
+		{0.125, 0.625, 0.375},
+	}
+	s, err := NewSobol(3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, w := range want {
+		p := s.Next()
+		for j := range w {
+			if p[j] != w[j] {
+				t.Fatalf("point %d = %v, want %v", i, p, w)
+			}
+		}
+	}
+}
+
+func TestSobolWrap(t *testing.T) {
+	s, err := NewSobol(2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Jump to the last 2 points of the period, the point of index n is the xor of the direction numbers of gray(n).
+	s.n = 1<<32 - 2
+	g := s.n ^ (s.n >> 1)
+	for j := range s.x {
+		s.x[j] = 0
+		for k := 0; k < 32; k++ {
+			if g&(1<<k) != 0 {
+				s.x[j] ^= s.v[j][k]
+			}
+		}
+	}
+	s.Next()
+	s.Next()
+	if s.n != 0 {
+		t.Fatalf("n = %d after the last point, want 0", s.n)
+	}
+	fresh, _ := NewSobol(2)
+	for i := 0; i < 4; i++ {
+		p, w := s.Next(), fresh.Next()
+		if p[0] != w[0] || p[1] != w[1] {
+			t.Fatalf("point %d after wrapping = %v, want %v", i, p, w)
+		}
+	}
+}
+
+func TestQuasiDiscrepancy(t *testing.T) {
+	const n = 256
+	rand.Seed(1)
+	pseudo := make([][]float64, n)
+	for i := range pseudo {
+		pseudo[i], _ = Float64N(0, 1, 2)
+	}
+	limit := Discrepancy(pseudo) / 2
+	sobol, _ := NewSobol(2)
+	halton, _ := NewHalton(2)
+	r2, _ := NewR(2)
+	for name, q := range map[string]QuasiRandom{"Sobol": sobol, "Halton": halton, "R": r2} {
+		if d := Discrepancy(QuasiN(q, n)); !(d < limit) {
+			t.Errorf("%s discrepancy = %g, want less than half of Float64N's %g", name, d, 2*limit)
+		}
+	}
+}
+
+func TestDiscrepancyOrigin(t *testing.T) {
+	// A single point at the origin lies in every box, so the discrepancy is the integral of (x*y - 1)^2 over the unit square.
+	want := math.Sqrt(1.0/9 - 2.0/4 + 1)
+	if d := Discrepancy([][]float64{{0, 0}}); math.Abs(d-want) > 1e-12 {
+		t.Errorf("Discrepancy = %g, want %g", d, want)
+	}
+}
@@ -22,6 +22,7 @@
 package random
 
 import (
+	"math"
 	"math/rand"
 	"time"
 )
@@ -133,6 +134,23 @@ func Float64N(startNum float64, endNum float64, n int) ([]float64, error) {
 	return r, nil
 }
 
+// int64Range is one of the inner functions of this package.
+// It returns a random int64 from the range [startNum, endNum], which may span all of int64.
+func int64Range(startNum int64, endNum int64, r *rand.Rand) int64 {
+	span := uint64(endNum - startNum)
+	switch {
+	case span < math.MaxInt64:
+		return startNum + r.Int63n(int64(span)+1)
+	case span == math.MaxUint64:
+		return int64(r.Uint64())
+	}
+	for {
+		if n := r.Uint64(); n <= span {
+			return startNum + int64(n)
+		}
+	}
+}
+
 func init() {
 	rand.Seed(time.Now().UnixNano())
 }
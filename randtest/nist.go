@@ -0,0 +1,232 @@
+/*
+ * File: nist.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package randtest
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/anonyindian/random-go"
+)
+
+// The tests in this file are a subset of NIST SP 800-22, "A Statistical Test Suite for
+// Random and Pseudorandom Number Generators for Cryptographic Applications", run on a sequence of bits.
+
+// Monobit function runs the NIST frequency (monobit) test, which checks that ones and zeros are equally common.
+// bits must hold at least 100 bits.
+// It returns the Result of the test and any write error encountered.
+func Monobit(bits []bool) (Result, error) {
+	const fn = "Monobit"
+	if len(bits) < 100 {
+		return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: need at least 100 bits", random.ErrInvalid)}
+	}
+	s := 0.0
+	for _, b := range bits {
+		if b {
+			s++
+		} else {
+			s--
+		}
+	}
+	obs := math.Abs(s) / math.Sqrt(float64(len(bits)))
+	return Result{"Monobit", obs, math.Erfc(obs / math.Sqrt2)}, nil
+}
+
+// BlockFrequency function runs the NIST frequency test within blocks of m bits,
+// which checks that ones make up half of every block.
+// bits must hold at least 100 bits and m must be at least 20.
+// It returns the Result of the test and any write error encountered.
+func BlockFrequency(bits []bool, m int) (Result, error) {
+	const fn = "BlockFrequency"
+	if len(bits) < 100 || m < 20 || len(bits)/m < 1 {
+		return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: need at least 100 bits and one block of at least 20 bits", random.ErrInvalid)}
+	}
+	n := len(bits) / m
+	x := 0.0
+	for i := 0; i < n; i++ {
+		ones := 0
+		for _, b := range bits[i*m : (i+1)*m] {
+			if b {
+				ones++
+			}
+		}
+		pi := float64(ones)/float64(m) - 0.5
+		x += pi * pi
+	}
+	x *= 4 * float64(m)
+	return Result{"BlockFrequency", x, chiSquarePValue(x, float64(n))}, nil
+}
+
+// BitRuns function runs the NIST runs test, which checks that runs of identical bits have the expected lengths.
+// bits must hold at least 100 bits. If the monobit frequency is too far from 1/2 the test
+// is not applicable and the p-value is 0, as specified by NIST.
+// It returns the Result of the test and any write error encountered.
+func BitRuns(bits []bool) (Result, error) {
+	const fn = "BitRuns"
+	if len(bits) < 100 {
+		return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: need at least 100 bits", random.ErrInvalid)}
+	}
+	n := float64(len(bits))
+	ones := 0.0
+	runs := 1.0
+	for i, b := range bits {
+		if b {
+			ones++
+		}
+		if i > 0 && b != bits[i-1] {
+			runs++
+		}
+	}
+	pi := ones / n
+	if math.Abs(pi-0.5) >= 2/math.Sqrt(n) {
+		return Result{"BitRuns", runs, 0}, nil
+	}
+	p := math.Erfc(math.Abs(runs-2*n*pi*(1-pi)) / (2 * math.Sqrt(2*n) * pi * (1 - pi)))
+	return Result{"BitRuns", runs, p}, nil
+}
+
+// LongestRunOfOnes function runs the NIST test for the longest run of ones in a block.
+// The block size is 8, 128 or 10000 bits depending on the length of bits, which must hold at least 128 bits.
+// It returns the Result of the test and any write error encountered.
+func LongestRunOfOnes(bits []bool) (Result, error) {
+	const fn = "LongestRunOfOnes"
+	var m, lowest int
+	var pi []float64
+	switch n := len(bits); {
+	case n < 128:
+		return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: need at least 128 bits", random.ErrInvalid)}
+	case n < 6272:
+		m, lowest, pi = 8, 1, []float64{0.2148, 0.3672, 0.2305, 0.1875}
+	case n < 750000:
+		m, lowest, pi = 128, 4, []float64{0.1174, 0.2430, 0.2493, 0.1752, 0.1027, 0.1124}
+	default:
+		m, lowest, pi = 10000, 10, []float64{0.0882, 0.2092, 0.2483, 0.1933, 0.1208, 0.0675, 0.0727}
+	}
+	blocks := len(bits) / m
+	v := make([]int, len(pi))
+	for i := 0; i < blocks; i++ {
+		longest, run := 0, 0
+		for _, b := range bits[i*m : (i+1)*m] {
+			if b {
+				run++
+				if run > longest {
+					longest = run
+				}
+			} else {
+				run = 0
+			}
+		}
+		c := longest - lowest
+		if c < 0 {
+			c = 0
+		}
+		if c >= len(v) {
+			c = len(v) - 1
+		}
+		v[c]++
+	}
+	x := 0.0
+	for i, p := range pi {
+		e := float64(blocks) * p
+		x += (float64(v[i]) - e) * (float64(v[i]) - e) / e
+	}
+	return Result{"LongestRunOfOnes", x, chiSquarePValue(x, float64(len(pi)-1))}, nil
+}
+
+// CumulativeSums function runs the NIST cumulative sums test in forward mode,
+// which checks that the random walk of the bits (+1 for a one, -1 for a zero) stays near zero.
+// bits must hold at least 100 bits.
+// It returns the Result of the test and any write error encountered.
+func CumulativeSums(bits []bool) (Result, error) {
+	const fn = "CumulativeSums"
+	if len(bits) < 100 {
+		return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: need at least 100 bits", random.ErrInvalid)}
+	}
+	s, z := 0, 0
+	for _, b := range bits {
+		if b {
+			s++
+		} else {
+			s--
+		}
+		if s > z {
+			z = s
+		} else if -s > z {
+			z = -s
+		}
+	}
+	n := float64(len(bits))
+	zf := float64(z)
+	sq := math.Sqrt(n)
+	sum1, sum2 := 0.0, 0.0
+	for k := int((-n/zf + 1) / 4); k <= int((n/zf-1)/4); k++ {
+		sum1 += normalCDF(float64(4*k+1)*zf/sq) - normalCDF(float64(4*k-1)*zf/sq)
+	}
+	for k := int((-n/zf - 3) / 4); k <= int((n/zf-1)/4); k++ {
+		sum2 += normalCDF(float64(4*k+3)*zf/sq) - normalCDF(float64(4*k+1)*zf/sq)
+	}
+	return Result{"CumulativeSums", zf, math.Max(0, math.Min(1, 1-sum1+sum2))}, nil
+}
+
+// ApproximateEntropy function runs the NIST approximate entropy test,
+// which compares the frequencies of all overlapping patterns of m and m+1 bits.
+// m must be positive and less than log2(len(bits)) - 5.
+// It returns the Result of the test and any write error encountered.
+func ApproximateEntropy(bits []bool, m int) (Result, error) {
+	const fn = "ApproximateEntropy"
+	if m < 1 || float64(m) >= math.Log2(float64(len(bits)))-5 {
+		return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: m must be in the range [1, log2(len(bits)) - 5)", random.ErrInvalid)}
+	}
+	n := len(bits)
+	phi := func(m int) float64 {
+		counts := make([]int, 1<<m)
+		p := 0
+		for i := 0; i < m-1; i++ {
+			p = p<<1 | bit(bits[i])
+		}
+		mask := 1<<m - 1
+		for i := m - 1; i < n+m-1; i++ {
+			p = (p<<1 | bit(bits[i%n])) & mask
+			counts[p]++
+		}
+		sum := 0.0
+		for _, c := range counts {
+			if c > 0 {
+				f := float64(c) / float64(n)
+				sum += f * math.Log(f)
+			}
+		}
+		return sum
+	}
+	apen := phi(m) - phi(m+1)
+	x := 2 * float64(n) * (math.Ln2 - apen)
+	return Result{"ApproximateEntropy", x, igamc(math.Pow(2, float64(m-1)), x/2)}, nil
+}
+
+// bit is one of the inner functions of this package.
+// It returns 1 for true and 0 for false.
+func bit(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
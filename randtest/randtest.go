@@ -0,0 +1,191 @@
+/*
+ * File: randtest.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+// Package randtest implements statistical tests which check the quality of random generators.
+// Every test returns a Result holding a p-value, small p-values mean the values are unlikely to be random.
+package randtest
+
+import (
+	"math"
+	"math/rand"
+)
+
+// Result records the outcome of a statistical test.
+type Result struct {
+	Name      string  // the name of the test
+	Statistic float64 // the test statistic
+	PValue    float64 // the probability of a statistic at least this extreme if the values were random
+}
+
+// Passed reports whether the p-value of the test is at least alpha, the significance level.
+// example: res.Passed(0.01), returns false only for the worst 1% of results of a perfect generator.
+func (r Result) Passed(alpha float64) bool {
+	return r.PValue >= alpha
+}
+
+// Battery function runs every test of this package on n values drawn from src.
+// n should be at least 100000 so that all the tests, including LongestRunOfOnes with large blocks, have enough data.
+// It returns the results in a slice of type []Result, tests which could not run on n values are left out.
+// example: randtest.Battery(rand.NewSource(1), 1000000), returns the results of all the tests for the math/rand source.
+func Battery(src rand.Source, n int) []Result {
+	r := rand.New(src)
+	res := BatteryFloat64(r.Float64, n)
+	bits := Bits(r, n)
+	for _, t := range []func([]bool) (Result, error){
+		Monobit,
+		func(b []bool) (Result, error) { return BlockFrequency(b, 128) },
+		BitRuns,
+		LongestRunOfOnes,
+		CumulativeSums,
+		func(b []bool) (Result, error) { return ApproximateEntropy(b, 8) },
+	} {
+		if t, err := t(bits); err == nil {
+			res = append(res, t)
+		}
+	}
+	days := make([][]uint64, 100)
+	for i := range days {
+		days[i] = make([]uint64, 512)
+		for j := range days[i] {
+			days[i][j] = r.Uint64() >> 40
+		}
+	}
+	if t, err := BirthdaySpacings(days, 1<<24); err == nil {
+		res = append(res, t)
+	}
+	return res
+}
+
+// BatteryFloat64 function runs the tests for uniform float64 values on n values drawn from next.
+// It is meant for generators which are not a rand.Source, like the functions of the random package.
+// It returns the results in a slice of type []Result, tests which could not run on n values are left out.
+// example: randtest.BatteryFloat64(func() float64 { f, _ := random.Float64(0, 1); return f }, 100000), tests random.Float64.
+func BatteryFloat64(next func() float64, n int) []Result {
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = next()
+	}
+	var res []Result
+	counts := make([]int, 100)
+	for _, v := range x {
+		if v >= 0 && v < 1 {
+			counts[int(v*100)]++
+		}
+	}
+	for _, t := range []func() (Result, error){
+		func() (Result, error) { return ChiSquareUniform(counts) },
+		func() (Result, error) { return KolmogorovSmirnov(x, UniformCDF) },
+		func() (Result, error) { return Runs(x) },
+		func() (Result, error) { return SerialCorrelation(x, 1) },
+	} {
+		if t, err := t(); err == nil {
+			res = append(res, t)
+		}
+	}
+	return res
+}
+
+// Bits function draws n random bits from r, to be used with the bit tests of this package.
+// It returns the bits in a slice of type []bool.
+func Bits(r *rand.Rand, n int) []bool {
+	b := make([]bool, n)
+	var w uint64
+	for i := range b {
+		if i%64 == 0 {
+			w = r.Uint64()
+		}
+		b[i] = w&1 == 1
+		w >>= 1
+	}
+	return b
+}
+
+// UniformCDF is the cumulative distribution function of the uniform distribution on [0, 1).
+// It can be given to KolmogorovSmirnov to test values of Float64N scaled to [0, 1).
+func UniformCDF(x float64) float64 {
+	return math.Max(0, math.Min(1, x))
+}
+
+// chiSquarePValue is one of the inner functions of this package.
+// It returns the probability of a chi-square statistic at least x with df degrees of freedom.
+func chiSquarePValue(x float64, df float64) float64 {
+	return igamc(df/2, x/2)
+}
+
+// normalPValue is one of the inner functions of this package.
+// It returns the two-sided probability of a standard normal value at least as far from 0 as z.
+func normalPValue(z float64) float64 {
+	return math.Erfc(math.Abs(z) / math.Sqrt2)
+}
+
+// normalCDF is one of the inner functions of this package.
+// It returns the cumulative distribution function of the standard normal distribution.
+func normalCDF(z float64) float64 {
+	return 0.5 * math.Erfc(-z/math.Sqrt2)
+}
+
+// igamc is one of the inner functions of this package.
+// It returns the regularized upper incomplete gamma function Q(a, x).
+func igamc(a float64, x float64) float64 {
+	if x <= 0 {
+		return 1
+	}
+	lg, _ := math.Lgamma(a)
+	if x < a+1 {
+		// series expansion of P(a, x)
+		sum, del, ap := 1/a, 1/a, a
+		for i := 0; i < 1000; i++ {
+			ap++
+			del *= x / ap
+			sum += del
+			if math.Abs(del) < math.Abs(sum)*1e-15 {
+				break
+			}
+		}
+		return math.Max(0, 1-sum*math.Exp(-x+a*math.Log(x)-lg))
+	}
+	// continued fraction of Q(a, x), evaluated with the modified Lentz method
+	const tiny = 1e-300
+	b := x + 1 - a
+	c := 1 / tiny
+	d := 1 / b
+	h := d
+	for i := 1; i < 1000; i++ {
+		an := -float64(i) * (float64(i) - a)
+		b += 2
+		d = an*d + b
+		if math.Abs(d) < tiny {
+			d = tiny
+		}
+		c = b + an/c
+		if math.Abs(c) < tiny {
+			c = tiny
+		}
+		d = 1 / d
+		del := d * c
+		h *= del
+		if math.Abs(del-1) < 1e-15 {
+			break
+		}
+	}
+	return math.Exp(-x+a*math.Log(x)-lg) * h
+}
@@ -0,0 +1,222 @@
+/*
+ * File: randtest_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package randtest_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/anonyindian/random-go"
+	"github.com/anonyindian/random-go/randtest"
+)
+
+// alpha is the significance level of the tests, low enough that a correct generator with a fixed seed never fails by chance.
+const alpha = 1e-4
+
+func check(t *testing.T, res randtest.Result, err error) {
+	t.Helper()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Passed(alpha) {
+		t.Errorf("%s: statistic %g, p-value %g", res.Name, res.Statistic, res.PValue)
+	}
+}
+
+func TestBatteryMathRand(t *testing.T) {
+	res := randtest.Battery(rand.NewSource(1), 200000)
+	if len(res) < 10 {
+		t.Fatalf("Battery ran %d tests, want at least 10", len(res))
+	}
+	for _, r := range res {
+		check(t, r, nil)
+	}
+}
+
+func TestFloat64N(t *testing.T) {
+	rand.Seed(1)
+	x, err := random.Float64N(0, 1, 100000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := 0
+	res := randtest.BatteryFloat64(func() float64 {
+		i++
+		return x[i-1]
+	}, len(x))
+	if len(res) != 4 {
+		t.Fatalf("BatteryFloat64 ran %d tests, want 4", len(res))
+	}
+	for _, r := range res {
+		check(t, r, nil)
+	}
+}
+
+func TestInteger(t *testing.T) {
+	rand.Seed(2)
+	counts := make([]int, 10)
+	x := make([]float64, 50000)
+	for i := range x {
+		v, err := random.Integer(0, 9)
+		if err != nil {
+			t.Fatal(err)
+		}
+		counts[v]++
+		x[i] = float64(v)
+	}
+	res, err := randtest.ChiSquareUniform(counts)
+	check(t, res, err)
+	res, err = randtest.SerialCorrelation(x, 1)
+	check(t, res, err)
+}
+
+func TestChoice(t *testing.T) {
+	rand.Seed(3)
+	a := []string{"a", "b", "c", "d", "e", "f", "g"}
+	index := make(map[string]int)
+	for i, s := range a {
+		index[s] = i
+	}
+	counts := make([]int, len(a))
+	anyCounts := make([]int, 3)
+	for i := 0; i < 70000; i++ {
+		counts[index[random.ChoiceString(a)]]++
+		anyCounts[random.Choice(0, 1, 2).(int)]++
+	}
+	res, err := randtest.ChiSquareUniform(counts)
+	check(t, res, err)
+	res, err = randtest.ChiSquareUniform(anyCounts)
+	check(t, res, err)
+}
+
+func TestShuffle(t *testing.T) {
+	rand.Seed(4)
+	const n = 5
+	// positions[v][p] counts how often the value v ended at position p, every position must be equally likely.
+	positions := make([][]int, n)
+	for v := range positions {
+		positions[v] = make([]int, n)
+	}
+	for i := 0; i < 50000; i++ {
+		a := random.ShuffleInt([]int{0, 1, 2, 3, 4})
+		for p, v := range a {
+			positions[v][p]++
+		}
+	}
+	for _, c := range positions {
+		res, err := randtest.ChiSquareUniform(c)
+		check(t, res, err)
+	}
+}
+
+func TestBatteryFloat64Rejects(t *testing.T) {
+	// A sorted sequence is perfectly uniform but has no randomness at all, the runs and correlation tests must catch it.
+	const n = 10000
+	i := 0
+	failed := 0
+	for _, r := range randtest.BatteryFloat64(func() float64 {
+		i++
+		return float64(i-1) / n
+	}, n) {
+		if !r.Passed(alpha) {
+			failed++
+		}
+	}
+	if failed < 2 {
+		t.Errorf("%d tests failed on a sorted sequence, want at least 2", failed)
+	}
+}
+
+func TestFloat32N(t *testing.T) {
+	rand.Seed(5)
+	x, err := random.Float32N(0, 1, 100000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := 0
+	for _, r := range randtest.BatteryFloat64(func() float64 {
+		i++
+		return float64(x[i-1])
+	}, len(x)) {
+		check(t, r, nil)
+	}
+}
+
+func TestChoiceN(t *testing.T) {
+	rand.Seed(6)
+	const n = 5
+	// pairs[a*n+b] counts how often ChoiceN picked a then b, every ordered pair of distinct values must be equally likely.
+	pairs := make([]int, n*n)
+	for i := 0; i < 40000; i++ {
+		c, err := random.ChoiceN(2, 0, 1, 2, 3, 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a, b := c[0].(int), c[1].(int)
+		if a == b {
+			t.Fatalf("ChoiceN picked %d twice", a)
+		}
+		pairs[a*n+b]++
+	}
+	var counts []int
+	for a := 0; a < n; a++ {
+		for b := 0; b < n; b++ {
+			if a != b {
+				counts = append(counts, pairs[a*n+b])
+			}
+		}
+	}
+	res, err := randtest.ChiSquareUniform(counts)
+	check(t, res, err)
+	// Picking every value removes each of them once from the choices.
+	all, err := random.ChoiceN(n, 0, 1, 2, 3, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := make([]bool, n)
+	for _, v := range all {
+		seen[v.(int)] = true
+	}
+	for v, ok := range seen {
+		if !ok {
+			t.Errorf("ChoiceN(%d) of %d values left out %d: %v", n, n, v, all)
+		}
+	}
+}
+
+func TestShuffleVariadic(t *testing.T) {
+	rand.Seed(7)
+	const n = 4
+	positions := make([][]int, n)
+	for v := range positions {
+		positions[v] = make([]int, n)
+	}
+	for i := 0; i < 40000; i++ {
+		for p, v := range random.Shuffle(0, 1, 2, 3) {
+			positions[v.(int)][p]++
+		}
+	}
+	for _, c := range positions {
+		res, err := randtest.ChiSquareUniform(c)
+		check(t, res, err)
+	}
+}
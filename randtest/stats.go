@@ -0,0 +1,227 @@
+/*
+ * File: stats.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package randtest
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/anonyindian/random-go"
+)
+
+// ChiSquare function runs Pearson's chi-square goodness-of-fit test.
+// observed (type []int) are the counts of each category and expected (type []float64) are the counts
+// expected for a perfect generator, both must have the same length and every expected count must be positive.
+// It returns the Result of the test and any write error encountered.
+// example: randtest.ChiSquare([]int{48, 52}, []float64{50, 50}), tests whether a coin is fair.
+func ChiSquare(observed []int, expected []float64) (Result, error) {
+	const fn = "ChiSquare"
+	if len(observed) < 2 {
+		return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: need at least 2 categories", random.ErrInvalid)}
+	}
+	if len(observed) != len(expected) {
+		return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: observed and expected must have the same length", random.ErrInvalid)}
+	}
+	x := 0.0
+	for i, o := range observed {
+		if !(expected[i] > 0) {
+			return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: expected count %d must be positive", random.ErrInvalid, i)}
+		}
+		d := float64(o) - expected[i]
+		x += d * d / expected[i]
+	}
+	return Result{"ChiSquare", x, chiSquarePValue(x, float64(len(observed)-1))}, nil
+}
+
+// ChiSquareUniform function runs Pearson's chi-square test against equal expected counts in every category.
+// It is the test to use for the counts of Choice, Integer or the positions of Shuffle.
+// It returns the Result of the test and any write error encountered.
+// example: randtest.ChiSquareUniform(counts), where counts[i] is how often random.Integer(0, 5) returned i.
+func ChiSquareUniform(observed []int) (Result, error) {
+	total := 0
+	for _, o := range observed {
+		total += o
+	}
+	expected := make([]float64, len(observed))
+	for i := range expected {
+		expected[i] = float64(total) / float64(len(observed))
+	}
+	return ChiSquare(observed, expected)
+}
+
+// KolmogorovSmirnov function runs the one-sample Kolmogorov-Smirnov test of samples against the distribution with the given cdf.
+// The p-value uses the asymptotic Kolmogorov distribution, it is accurate for 35 or more samples.
+// It returns the Result of the test and any write error encountered.
+// example: randtest.KolmogorovSmirnov(values, randtest.UniformCDF), tests values drawn from [0, 1).
+func KolmogorovSmirnov(samples []float64, cdf func(float64) float64) (Result, error) {
+	const fn = "KolmogorovSmirnov"
+	if len(samples) == 0 {
+		return Result{}, &random.Error{Func: fn, Err: random.ErrEmpty}
+	}
+	x := append([]float64(nil), samples...)
+	sort.Float64s(x)
+	n := float64(len(x))
+	d := 0.0
+	for i, v := range x {
+		f := cdf(v)
+		d = math.Max(d, math.Max(float64(i+1)/n-f, f-float64(i)/n))
+	}
+	sn := math.Sqrt(n)
+	return Result{"KolmogorovSmirnov", d, kolmogorovQ((sn + 0.12 + 0.11/sn) * d)}, nil
+}
+
+// Runs function runs the Wald-Wolfowitz runs test, counting the runs of samples above and below their median.
+// Too few runs mean neighbouring values are alike, too many mean they alternate.
+// It returns the Result of the test and any write error encountered.
+func Runs(samples []float64) (Result, error) {
+	const fn = "Runs"
+	if len(samples) < 20 {
+		return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: need at least 20 samples", random.ErrInvalid)}
+	}
+	x := append([]float64(nil), samples...)
+	sort.Float64s(x)
+	median := x[len(x)/2]
+	if len(x)%2 == 0 {
+		median = (x[len(x)/2-1] + x[len(x)/2]) / 2
+	}
+	var above, below, runs float64
+	last := 0
+	for _, v := range samples {
+		side := 0
+		switch {
+		case v > median:
+			side = 1
+			above++
+		case v < median:
+			side = -1
+			below++
+		default:
+			continue
+		}
+		if side != last {
+			runs++
+			last = side
+		}
+	}
+	if above == 0 || below == 0 {
+		return Result{"Runs", runs, 0}, nil
+	}
+	mean := 2*above*below/(above+below) + 1
+	variance := (mean - 1) * (mean - 2) / (above + below - 1)
+	z := (runs - mean) / math.Sqrt(variance)
+	return Result{"Runs", z, normalPValue(z)}, nil
+}
+
+// SerialCorrelation function tests the correlation between samples which are lag positions apart.
+// The p-value is two-sided and uses the normal approximation of the correlation coefficient.
+// It returns the Result of the test, with the correlation coefficient as its statistic, and any write error encountered.
+// example: randtest.SerialCorrelation(values, 1), tests whether every value depends on the previous one.
+func SerialCorrelation(samples []float64, lag int) (Result, error) {
+	const fn = "SerialCorrelation"
+	if lag < 1 || len(samples) < lag+20 {
+		return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: need a positive lag and at least lag+20 samples", random.ErrInvalid)}
+	}
+	n := float64(len(samples))
+	mean := 0.0
+	for _, v := range samples {
+		mean += v
+	}
+	mean /= n
+	num, den := 0.0, 0.0
+	for i, v := range samples {
+		den += (v - mean) * (v - mean)
+		if i+lag < len(samples) {
+			num += (v - mean) * (samples[i+lag] - mean)
+		}
+	}
+	if den == 0 {
+		return Result{"SerialCorrelation", 1, 0}, nil
+	}
+	c := num / den
+	return Result{"SerialCorrelation", c, normalPValue((c + 1/n) * math.Sqrt(n))}, nil
+}
+
+// BirthdaySpacings function runs Marsaglia's birthday spacings test.
+// Every element of birthdays is one trial of m birthdays drawn from a year of the given number of days.
+// The birthdays of a trial are sorted, and the number of repeated spacings between them follows
+// a Poisson distribution with mean m^3/(4*days), which is compared with the total over all trials.
+// It returns the Result of the test and any write error encountered.
+// example: randtest.BirthdaySpacings(trials, 1<<24), with 512 birthdays below 1<<24 in every trial.
+func BirthdaySpacings(birthdays [][]uint64, days uint64) (Result, error) {
+	const fn = "BirthdaySpacings"
+	if len(birthdays) == 0 {
+		return Result{}, &random.Error{Func: fn, Err: random.ErrEmpty}
+	}
+	lambda := 0.0
+	total := 0
+	for _, trial := range birthdays {
+		if len(trial) < 2 {
+			return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: need at least 2 birthdays in every trial", random.ErrInvalid)}
+		}
+		m := float64(len(trial))
+		lambda += m * m * m / (4 * float64(days))
+		b := make([]uint64, len(trial))
+		for i, d := range trial {
+			if d >= days {
+				return Result{}, &random.Error{Func: fn, Err: fmt.Errorf("%w: birthday %d is not below days", random.ErrInvalid, d)}
+			}
+			b[i] = d
+		}
+		sort.Slice(b, func(i, j int) bool { return b[i] < b[j] })
+		s := make([]uint64, len(b)-1)
+		for i := range s {
+			s[i] = b[i+1] - b[i]
+		}
+		sort.Slice(s, func(i, j int) bool { return s[i] < s[j] })
+		for i := 1; i < len(s); i++ {
+			if s[i] == s[i-1] {
+				total++
+			}
+		}
+	}
+	// two-sided p-value of the total, which is Poisson distributed with mean lambda
+	below := igamc(float64(total)+1, lambda) // P(X <= total)
+	above := 1.0                             // P(X >= total)
+	if total > 0 {
+		above = 1 - igamc(float64(total), lambda)
+	}
+	return Result{"BirthdaySpacings", float64(total), math.Min(1, 2*math.Min(below, above))}, nil
+}
+
+// kolmogorovQ is one of the inner functions of this package.
+// It returns the complementary cumulative distribution function of the Kolmogorov distribution.
+func kolmogorovQ(lambda float64) float64 {
+	if lambda < 0.2 {
+		return 1
+	}
+	sum, sign := 0.0, 1.0
+	for k := 1.0; k <= 100; k++ {
+		term := sign * 2 * math.Exp(-2*k*k*lambda*lambda)
+		sum += term
+		if math.Abs(term) < 1e-12 {
+			break
+		}
+		sign = -sign
+	}
+	return math.Max(0, math.Min(1, sum))
+}
@@ -0,0 +1,275 @@
+/*
+ * File: regex.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultMaxRepeat is the default upper bound for the repetitions of *, + and {n,} in RegexString.
+const DefaultMaxRepeat = 10
+
+// alphabetPrintable is the default alphabet of RegexString for . and it is printable ASCII.
+var alphabetPrintable = Alphabet(" !\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstuvwxyz{|}~")
+
+// RegexString is a Distribution of strings matching a regular expression, which is useful to fuzz input validators.
+// It supports the RE2 syntax of package regexp, except for word boundaries and
+// anchors which are not at the beginning or the end of the expression.
+type RegexString struct {
+	MaxRepeat int        // the upper bound for the repetitions of *, + and {n,}, DefaultMaxRepeat by default
+	Any       Alphabet   // the runes drawn for . and its negated classes, printable ASCII by default
+	Rand      *rand.Rand // the generator used for sampling, nil uses Default()
+
+	re *syntax.Regexp
+}
+
+// NewRegexString function is used to get a Distribution of strings matching pattern.
+// Unsupported constructs, like backreferences, lookarounds and anchors in odd positions, are reported with ErrUnsupported.
+// It returns the distribution of type *RegexString and any write error encountered.
+// example: random.NewRegexString(`[A-Z]{3}-\d{4}`), returns a distribution of strings like "QXA-4821".
+func NewRegexString(pattern string) (*RegexString, error) {
+	const fn = "NewRegexString"
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		var serr *syntax.Error
+		if errors.As(err, &serr) {
+			switch {
+			case serr.Code == syntax.ErrInvalidEscape && len(serr.Expr) == 2 && serr.Expr[1] >= '1' && serr.Expr[1] <= '9':
+				return nil, &Error{fn, fmt.Errorf("%w: backreference %s", ErrUnsupported, serr.Expr)}
+			case strings.HasPrefix(serr.Expr, "(?=") || strings.HasPrefix(serr.Expr, "(?!") ||
+				strings.HasPrefix(serr.Expr, "(?<=") || strings.HasPrefix(serr.Expr, "(?<!"):
+				return nil, &Error{fn, fmt.Errorf("%w: lookaround %s", ErrUnsupported, serr.Expr)}
+			}
+		}
+		return nil, &Error{fn, fmt.Errorf("%w: %v", ErrInvalid, err)}
+	}
+	if err := checkRegex(re, true, true); err != nil {
+		return nil, &Error{fn, err}
+	}
+	return &RegexString{MaxRepeat: DefaultMaxRepeat, re: re}, nil
+}
+
+// Sample draws a random string matching the regular expression.
+func (g *RegexString) Sample() string {
+	var b strings.Builder
+	g.write(&b, g.re, rng(g.Rand))
+	return b.String()
+}
+
+// SampleN draws n random strings matching the regular expression.
+func (g *RegexString) SampleN(n int) []string {
+	return sampleN[string](g, n)
+}
+
+// write is one of the inner functions of this package.
+// It writes a random string matching re to b.
+func (g *RegexString) write(b *strings.Builder, re *syntax.Regexp, r *rand.Rand) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, c := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 {
+				c = foldRune(c, r)
+			}
+			b.WriteRune(c)
+		}
+	case syntax.OpCharClass:
+		b.WriteRune(g.classRune(re.Rune, r))
+	case syntax.OpAnyCharNotNL, syntax.OpAnyChar:
+		alphabet := g.anyAlphabet(re.Op == syntax.OpAnyCharNotNL)
+		c := alphabet[r.Intn(len(alphabet))]
+		if re.Op == syntax.OpAnyChar && r.Intn(len(alphabet)+1) == 0 {
+			c = '\n'
+		}
+		b.WriteRune(c)
+	case syntax.OpCapture:
+		g.write(b, re.Sub[0], r)
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			g.write(b, sub, r)
+		}
+	case syntax.OpAlternate:
+		g.write(b, re.Sub[r.Intn(len(re.Sub))], r)
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := re.Min, re.Max
+		switch re.Op {
+		case syntax.OpStar:
+			min, max = 0, -1
+		case syntax.OpPlus:
+			min, max = 1, -1
+		case syntax.OpQuest:
+			min, max = 0, 1
+		}
+		if max == -1 {
+			max = g.MaxRepeat
+			if max < min {
+				max = min
+			}
+		}
+		for i := min + r.Intn(max-min+1); i > 0; i-- {
+			g.write(b, re.Sub[0], r)
+		}
+	}
+	// OpEmptyMatch and the anchors allowed by checkRegex match the empty string.
+}
+
+// anyAlphabet is one of the inner functions of this package.
+// It returns the runes drawn for ., without '\n' if notNL is set, falling back to printable ASCII if none are left.
+func (g *RegexString) anyAlphabet(notNL bool) Alphabet {
+	alphabet := g.Any
+	if notNL {
+		for i, c := range alphabet {
+			if c == '\n' {
+				alphabet = append(append(Alphabet(nil), alphabet[:i]...), alphabet[i+1:]...)
+				break
+			}
+		}
+	}
+	if len(alphabet) == 0 {
+		return alphabetPrintable
+	}
+	return alphabet
+}
+
+// classRune is one of the inner functions of this package.
+// It returns a random rune from the character class ranges, which are pairs of lo and hi runes.
+// Negated classes can include huge ranges of unprintable runes, so runes of the Any alphabet are preferred when they match.
+func (g *RegexString) classRune(ranges []rune, r *rand.Rand) rune {
+	inClass := func(c rune) bool {
+		for i := 0; i < len(ranges); i += 2 {
+			if c >= ranges[i] && c <= ranges[i+1] {
+				return true
+			}
+		}
+		return false
+	}
+	total := 0
+	for i := 0; i < len(ranges); i += 2 {
+		total += int(ranges[i+1]-ranges[i]) + 1
+	}
+	if total > 0x1000 {
+		alphabet := g.Any
+		if len(alphabet) == 0 {
+			alphabet = alphabetPrintable
+		}
+		var fits []rune
+		for _, c := range alphabet {
+			if inClass(c) {
+				fits = append(fits, c)
+			}
+		}
+		if len(fits) > 0 {
+			return fits[r.Intn(len(fits))]
+		}
+	}
+	for {
+		n := r.Intn(total)
+		for i := 0; i < len(ranges); i += 2 {
+			size := int(ranges[i+1]-ranges[i]) + 1
+			if n < size {
+				if c := ranges[i] + rune(n); utf8.ValidRune(c) {
+					return c
+				}
+				break
+			}
+			n -= size
+		}
+	}
+}
+
+// Regex function is used to get a random string matching pattern.
+// It returns the randomly generated string and any write error encountered.
+// example: random.Regex(`[a-z]+@example\.(com|org)`), returns a random email address at example.com or example.org.
+func Regex(pattern string) (string, error) {
+	g, err := NewRegexString(pattern)
+	if err != nil {
+		return "", err
+	}
+	return g.Sample(), nil
+}
+
+// checkRegex is one of the inner functions of this package.
+// It reports the constructs of re which can't be generated.
+// atStart and atEnd tell whether re is at the beginning or the end of the whole expression.
+func checkRegex(re *syntax.Regexp, atStart bool, atEnd bool) error {
+	switch re.Op {
+	case syntax.OpNoMatch:
+		return fmt.Errorf("%w: expression which matches nothing", ErrUnsupported)
+	case syntax.OpLiteral:
+		for _, c := range re.Rune {
+			if !utf8.ValidRune(c) {
+				return fmt.Errorf("%w: rune %U which can't be encoded in UTF-8", ErrUnsupported, c)
+			}
+		}
+	case syntax.OpCharClass:
+		valid := false
+		for i := 0; i < len(re.Rune); i += 2 {
+			// Only the surrogate halves can't be encoded in UTF-8, any range reaching out of them has a valid rune.
+			if re.Rune[i] < 0xD800 || re.Rune[i+1] > 0xDFFF {
+				valid = true
+			}
+		}
+		if !valid {
+			return fmt.Errorf("%w: character class without a valid rune", ErrUnsupported)
+		}
+	case syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return fmt.Errorf("%w: word boundary", ErrUnsupported)
+	case syntax.OpBeginLine, syntax.OpBeginText:
+		if !atStart {
+			return fmt.Errorf("%w: anchor %s which is not at the beginning", ErrUnsupported, re)
+		}
+	case syntax.OpEndLine, syntax.OpEndText:
+		if !atEnd {
+			return fmt.Errorf("%w: anchor %s which is not at the end", ErrUnsupported, re)
+		}
+	case syntax.OpCapture, syntax.OpAlternate:
+		for _, sub := range re.Sub {
+			if err := checkRegex(sub, atStart, atEnd); err != nil {
+				return err
+			}
+		}
+	case syntax.OpConcat:
+		for i, sub := range re.Sub {
+			if err := checkRegex(sub, atStart && i == 0, atEnd && i == len(re.Sub)-1); err != nil {
+				return err
+			}
+		}
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		return checkRegex(re.Sub[0], false, false)
+	}
+	return nil
+}
+
+// foldRune is one of the inner functions of this package.
+// It returns a random rune which is equal to c under simple case folding, like 'k', 'K' or the Kelvin sign for 'k'.
+func foldRune(c rune, r *rand.Rand) rune {
+	orbit := []rune{c}
+	for f := unicode.SimpleFold(c); f != c; f = unicode.SimpleFold(f) {
+		orbit = append(orbit, f)
+	}
+	return orbit[r.Intn(len(orbit))]
+}
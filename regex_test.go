@@ -0,0 +1,68 @@
+/*
+ * File: regex_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anonyindian/random-go"
+)
+
+func TestRegexInvalidClass(t *testing.T) {
+	// Surrogate halves compile in package regexp but can't be generated, they must not hang Sample.
+	for _, p := range []string{`[\x{D800}-\x{DFFF}]`, `x[\x{DC00}]`} {
+		if _, err := random.NewRegexString(p); !errors.Is(err, random.ErrUnsupported) {
+			t.Errorf("NewRegexString(%s) error = %v, want ErrUnsupported", p, err)
+		}
+	}
+	g, err := random.NewRegexString(`[\x{D7FF}-\x{DFFF}]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := g.Sample(); s != "\uD7FF" {
+		t.Errorf("Sample() = %q, want the only valid rune of the class", s)
+	}
+}
+
+func TestRegexDotWithoutNewline(t *testing.T) {
+	g, err := random.NewRegexString(`.{20}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.Rand = random.NewRand(1)
+	for _, any := range []string{"\nx", "\n"} {
+		g.Any = random.Alphabet(any)
+		for i := 0; i < 100; i++ {
+			if s := g.Sample(); strings.ContainsRune(s, '\n') || len(s) != 20 {
+				t.Fatalf("with Any %q, . generated %q", any, s)
+			}
+		}
+	}
+	// (?s) lets . match '\n' too.
+	g, _ = random.NewRegexString(`(?s).`)
+	g.Any = random.Alphabet("\n")
+	if s := g.Sample(); s != "\n" {
+		t.Errorf("(?s). with Any \"\\n\" = %q", s)
+	}
+}
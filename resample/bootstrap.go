@@ -0,0 +1,145 @@
+/*
+ * File: bootstrap.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package resample
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/anonyindian/random-go"
+)
+
+// Bootstrap function is used to estimate the uncertainty of stat on x by drawing c.Resamples samples of x
+// with replacement. The interval is the percentile interval of the replicates.
+// It returns the Estimate of type *Estimate and any write error encountered.
+// example: resample.Bootstrap(latencies, resample.Median, resample.Config{Seed: 7}), returns a 95% confidence interval of the median latency.
+func Bootstrap(x []float64, stat Statistic, c Config) (*Estimate, error) {
+	const fn = "Bootstrap"
+	if len(x) == 0 {
+		return nil, &random.Error{Func: fn, Err: random.ErrEmpty}
+	}
+	c, err := c.withDefaults()
+	if err != nil {
+		return nil, &random.Error{Func: fn, Err: err}
+	}
+	reps := parallel(c.Resamples, c, true, func() func(r *rand.Rand, i int) float64 {
+		s := make([]float64, len(x))
+		return func(r *rand.Rand, _ int) float64 {
+			for j := range s {
+				s[j] = x[r.Intn(len(x))]
+			}
+			return stat(s)
+		}
+	})
+	return bootstrapEstimate(stat(x), reps, c), nil
+}
+
+// BlockBootstrap function is used to bootstrap stat on a time series x, keeping the dependence between close values.
+// The resamples are made of blocks of blockLen consecutive values starting at random positions,
+// the blocks wrap around the end of x (the circular block bootstrap) so that every value is equally likely to be drawn.
+// blockLen should be longer than the range of the dependence, like the lag at which the autocorrelation fades.
+// It returns the Estimate of type *Estimate and any write error encountered.
+// example: resample.BlockBootstrap(daily, 7, resample.Mean, resample.Config{}), returns a confidence interval of the mean of a series with weekly patterns.
+func BlockBootstrap(x []float64, blockLen int, stat Statistic, c Config) (*Estimate, error) {
+	const fn = "BlockBootstrap"
+	if len(x) == 0 {
+		return nil, &random.Error{Func: fn, Err: random.ErrEmpty}
+	}
+	if blockLen < 1 || blockLen > len(x) {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: blockLen must be in the range [1, len(x)]", random.ErrInvalid)}
+	}
+	c, err := c.withDefaults()
+	if err != nil {
+		return nil, &random.Error{Func: fn, Err: err}
+	}
+	reps := parallel(c.Resamples, c, true, func() func(r *rand.Rand, i int) float64 {
+		s := make([]float64, len(x))
+		return func(r *rand.Rand, _ int) float64 {
+			for j := 0; j < len(s); {
+				start := r.Intn(len(x))
+				for b := 0; b < blockLen && j < len(s); b++ {
+					s[j] = x[(start+b)%len(x)]
+					j++
+				}
+			}
+			return stat(s)
+		}
+	})
+	return bootstrapEstimate(stat(x), reps, c), nil
+}
+
+// bootstrapEstimate is one of the inner functions of this package.
+// It summarizes the replicates of a bootstrap of the statistic value.
+func bootstrapEstimate(value float64, reps []float64, c Config) *Estimate {
+	m, sd := meanStd(reps)
+	sorted := append([]float64(nil), reps...)
+	sort.Float64s(sorted)
+	alpha := 1 - c.Confidence
+	return &Estimate{
+		Value:      value,
+		Bias:       m - value,
+		StdErr:     sd,
+		Interval:   Interval{percentile(sorted, alpha/2), percentile(sorted, 1-alpha/2)},
+		Replicates: reps,
+	}
+}
+
+// Jackknife function is used to estimate the bias and the standard error of stat on x by leaving out one value at a time.
+// It needs no random numbers, so c.Resamples and c.Seed are ignored, the len(x) replicates are computed in parallel.
+// The interval is the normal interval around the bias-corrected value.
+// It returns the Estimate of type *Estimate and any write error encountered.
+// example: resample.Jackknife(x, resample.Mean, resample.Config{}), returns the standard error of the mean of x.
+func Jackknife(x []float64, stat Statistic, c Config) (*Estimate, error) {
+	const fn = "Jackknife"
+	if len(x) < 2 {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: need at least 2 values", random.ErrInvalid)}
+	}
+	c.Resamples = len(x)
+	c, err := c.withDefaults()
+	if err != nil {
+		return nil, &random.Error{Func: fn, Err: err}
+	}
+	reps := parallel(len(x), c, false, func() func(r *rand.Rand, i int) float64 {
+		s := make([]float64, len(x)-1)
+		return func(_ *rand.Rand, i int) float64 {
+			copy(s, x[:i])
+			copy(s[i:], x[i+1:])
+			return stat(s)
+		}
+	})
+	n := float64(len(x))
+	value := stat(x)
+	m, sd := meanStd(reps)
+	bias := (n - 1) * (m - value)
+	// The jackknife variance is (n - 1) / n * sum (reps - m)^2, which is (n - 1)^2 / n times the sample variance.
+	se := sd * (n - 1) / math.Sqrt(n)
+	z := math.Sqrt2 * math.Erfinv(c.Confidence)
+	return &Estimate{
+		Value:      value,
+		Bias:       bias,
+		StdErr:     se,
+		Interval:   Interval{value - bias - z*se, value - bias + z*se},
+		Replicates: reps,
+	}, nil
+}
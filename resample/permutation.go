@@ -0,0 +1,89 @@
+/*
+ * File: permutation.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package resample
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/anonyindian/random-go"
+)
+
+// TestResult is the result of a permutation test.
+type TestResult struct {
+	Statistic  float64   // the statistic of the original samples
+	PValue     float64   // the probability of a statistic at least as extreme under the null hypothesis
+	Replicates []float64 // the statistic of every permutation
+}
+
+// PermutationTest function is used to test whether x and y come from the same distribution, using stat as the test statistic.
+// Every resample shuffles the pooled values and splits them into samples of the sizes of x and y.
+// The p-value counts the permutations with a statistic at least as extreme as the original one, in the direction
+// of c.Alternative, and is (count + 1) / (c.Resamples + 1) so that it is never 0.
+// TwoSided compares the absolute values, so it suits statistics which are centered on 0 under the null hypothesis, like MeanDiff.
+// It returns the TestResult of type *TestResult and any write error encountered.
+// example: resample.PermutationTest(control, treatment, resample.MeanDiff, resample.Config{Alternative: resample.Less}), tests whether treatment has a greater mean.
+func PermutationTest(x []float64, y []float64, stat TwoSampleStatistic, c Config) (*TestResult, error) {
+	const fn = "PermutationTest"
+	if len(x) == 0 || len(y) == 0 {
+		return nil, &random.Error{Func: fn, Err: random.ErrEmpty}
+	}
+	c, err := c.withDefaults()
+	if err != nil {
+		return nil, &random.Error{Func: fn, Err: err}
+	}
+	pooled := append(append([]float64(nil), x...), y...)
+	reps := parallel(c.Resamples, c, true, func() func(r *rand.Rand, i int) float64 {
+		s := make([]float64, len(pooled))
+		return func(r *rand.Rand, _ int) float64 {
+			// Starting from the same order every time keeps the results independent of the scheduling of the chunks.
+			copy(s, pooled)
+			r.Shuffle(len(s), func(i, j int) {
+				s[i], s[j] = s[j], s[i]
+			})
+			return stat(s[:len(x)], s[len(x):])
+		}
+	})
+	value := stat(x, y)
+	count := 0
+	for _, v := range reps {
+		switch c.Alternative {
+		case Greater:
+			if v >= value {
+				count++
+			}
+		case Less:
+			if v <= value {
+				count++
+			}
+		default:
+			if math.Abs(v) >= math.Abs(value) {
+				count++
+			}
+		}
+	}
+	return &TestResult{
+		Statistic:  value,
+		PValue:     float64(count+1) / float64(len(reps)+1),
+		Replicates: reps,
+	}, nil
+}
@@ -0,0 +1,205 @@
+/*
+ * File: resample.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+// Package resample estimates the uncertainty of statistics by resampling the data:
+// bootstrap and block bootstrap confidence intervals, the jackknife and permutation tests.
+// The resamples are drawn in parallel, each chunk of resamples by its own generator seeded from Config.Seed,
+// so the results only depend on the seed and not on the number of workers or their scheduling.
+package resample
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/anonyindian/random-go"
+)
+
+// The defaults of Config.
+const (
+	DefaultResamples  = 10000
+	DefaultConfidence = 0.95
+)
+
+// chunkSize is the number of resamples drawn by the generator of one chunk.
+const chunkSize = 256
+
+// Statistic computes a value, like the mean, from a sample.
+// It is called from several goroutines at once, so it must not modify x or shared state.
+type Statistic func(x []float64) float64
+
+// TwoSampleStatistic computes a value, like the difference of the means, from two samples.
+// It is called from several goroutines at once, so it must not modify x, y or shared state.
+type TwoSampleStatistic func(x []float64, y []float64) float64
+
+// Alternative is the alternative hypothesis of a permutation test.
+type Alternative int
+
+const (
+	TwoSided Alternative = iota // the statistic differs from what the null hypothesis gives, in either direction
+	Greater                     // the statistic is greater than what the null hypothesis gives
+	Less                        // the statistic is less than what the null hypothesis gives
+)
+
+// Config controls the resampling. The zero value uses the defaults and the seed 0.
+type Config struct {
+	Resamples   int         // the number of resamples, DefaultResamples if it is 0
+	Confidence  float64     // the level of the confidence intervals in the range (0, 1), DefaultConfidence if it is 0
+	Workers     int         // the number of goroutines, runtime.GOMAXPROCS(0) if it is 0
+	Seed        int64       // the seed of the generators, the same seed gives the same results
+	Alternative Alternative // the alternative hypothesis of PermutationTest
+}
+
+// Interval is a confidence interval.
+type Interval struct {
+	Lo float64 // the lower bound
+	Hi float64 // the upper bound
+}
+
+// Estimate is the result of a bootstrap or the jackknife.
+type Estimate struct {
+	Value      float64   // the statistic of the original sample
+	Bias       float64   // the estimated bias of the statistic
+	StdErr     float64   // the estimated standard error of the statistic
+	Interval   Interval  // the confidence interval at Config.Confidence
+	Replicates []float64 // the statistic of every resample
+}
+
+// withDefaults is one of the inner functions of this package.
+// It checks c and returns it with the defaults filled in.
+func (c Config) withDefaults() (Config, error) {
+	if c.Resamples == 0 {
+		c.Resamples = DefaultResamples
+	}
+	if c.Confidence == 0 {
+		c.Confidence = DefaultConfidence
+	}
+	if c.Workers <= 0 {
+		c.Workers = runtime.GOMAXPROCS(0)
+	}
+	if c.Resamples < 1 {
+		return c, fmt.Errorf("%w: Resamples must be positive", random.ErrInvalid)
+	}
+	if !(c.Confidence > 0 && c.Confidence < 1) {
+		return c, fmt.Errorf("%w: Confidence must be in the range (0, 1)", random.ErrInvalid)
+	}
+	return c, nil
+}
+
+// parallel is one of the inner functions of this package.
+// It returns out[i] = task(r, i) for every i in [0, n), computed by c.Workers goroutines.
+// newTask is called once by every goroutine, so that the task can keep its own buffers.
+// Chunks of chunkSize indexes are computed with a generator seeded from c.Seed and the chunk,
+// r is nil if seeded is false.
+func parallel(n int, c Config, seeded bool, newTask func() func(r *rand.Rand, i int) float64) []float64 {
+	out := make([]float64, n)
+	chunks := (n + chunkSize - 1) / chunkSize
+	next := make(chan int, chunks)
+	for k := 0; k < chunks; k++ {
+		next <- k
+	}
+	close(next)
+	workers := c.Workers
+	if workers > chunks {
+		workers = chunks
+	}
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			task := newTask()
+			for k := range next {
+				var r *rand.Rand
+				if seeded {
+					r = rand.New(rand.NewSource(chunkSeed(c.Seed, k)))
+				}
+				for i := k * chunkSize; i < n && i < (k+1)*chunkSize; i++ {
+					out[i] = task(r, i)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	return out
+}
+
+// chunkSeed is one of the inner functions of this package.
+// It mixes seed and chunk with splitmix64, so that the chunks get unrelated generators.
+func chunkSeed(seed int64, chunk int) int64 {
+	z := uint64(seed) + uint64(chunk+1)*0x9e3779b97f4a7c15
+	z = (z ^ (z >> 30)) * 0xbf58476d1ce4e5b9
+	z = (z ^ (z >> 27)) * 0x94d049bb133111eb
+	return int64(z ^ (z >> 31))
+}
+
+// percentile is one of the inner functions of this package.
+// It returns the value below which a fraction p of the sorted values falls, interpolating linearly.
+func percentile(sorted []float64, p float64) float64 {
+	pos := p * float64(len(sorted)-1)
+	i := int(pos)
+	if i >= len(sorted)-1 {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[i] + (pos-float64(i))*(sorted[i+1]-sorted[i])
+}
+
+// meanStd is one of the inner functions of this package.
+// It returns the mean and the sample standard deviation of x.
+func meanStd(x []float64) (float64, float64) {
+	m := Mean(x)
+	if len(x) < 2 {
+		return m, 0
+	}
+	ss := 0.0
+	for _, v := range x {
+		ss += (v - m) * (v - m)
+	}
+	return m, math.Sqrt(ss / float64(len(x)-1))
+}
+
+// Mean function returns the arithmetic mean of x, it is a Statistic.
+// example: resample.Bootstrap(x, resample.Mean, resample.Config{}), returns a confidence interval of the mean of x.
+func Mean(x []float64) float64 {
+	sum := 0.0
+	for _, v := range x {
+		sum += v
+	}
+	return sum / float64(len(x))
+}
+
+// Median function returns the median of x, it is a Statistic.
+// x is copied before sorting, so it isn't modified.
+func Median(x []float64) float64 {
+	s := append([]float64(nil), x...)
+	sort.Float64s(s)
+	return percentile(s, 0.5)
+}
+
+// MeanDiff function returns the mean of x minus the mean of y, it is a TwoSampleStatistic.
+// example: resample.PermutationTest(a, b, resample.MeanDiff, resample.Config{}), tests whether a and b have the same mean.
+func MeanDiff(x []float64, y []float64) float64 {
+	return Mean(x) - Mean(y)
+}
@@ -0,0 +1,150 @@
+/*
+ * File: resample_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package resample_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/anonyindian/random-go"
+	"github.com/anonyindian/random-go/resample"
+)
+
+// sample returns n normal values with the given mean and a standard deviation of 1, from a fixed seed.
+func sample(n int, mean float64, seed int64) []float64 {
+	r := random.NewRand(seed)
+	x := make([]float64, n)
+	for i := range x {
+		x[i] = mean + r.NormFloat64()
+	}
+	return x
+}
+
+func sameReplicates(t *testing.T, name string, a []float64, b []float64) {
+	t.Helper()
+	if len(a) != len(b) {
+		t.Fatalf("%s: %d and %d replicates", name, len(a), len(b))
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("%s: replicate %d is %v with 1 worker and %v with 8", name, i, a[i], b[i])
+		}
+	}
+}
+
+func TestWorkersReproducible(t *testing.T) {
+	x, y := sample(50, 0, 1), sample(40, 0.3, 2)
+	// 3000 resamples make several chunks, so that the workers share them.
+	var boot, block [2]*resample.Estimate
+	var perm [2]*resample.TestResult
+	for i, workers := range []int{1, 8} {
+		c := resample.Config{Resamples: 3000, Workers: workers, Seed: 42}
+		var err error
+		if boot[i], err = resample.Bootstrap(x, resample.Median, c); err != nil {
+			t.Fatal(err)
+		}
+		if block[i], err = resample.BlockBootstrap(x, 5, resample.Mean, c); err != nil {
+			t.Fatal(err)
+		}
+		if perm[i], err = resample.PermutationTest(x, y, resample.MeanDiff, c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	sameReplicates(t, "Bootstrap", boot[0].Replicates, boot[1].Replicates)
+	sameReplicates(t, "BlockBootstrap", block[0].Replicates, block[1].Replicates)
+	sameReplicates(t, "PermutationTest", perm[0].Replicates, perm[1].Replicates)
+	if boot[0].Interval != boot[1].Interval || perm[0].PValue != perm[1].PValue {
+		t.Error("the intervals or p-values differ with the number of workers")
+	}
+	other, err := resample.Bootstrap(x, resample.Median, resample.Config{Resamples: 3000, Seed: 43})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Replicates[0] == boot[0].Replicates[0] && other.Replicates[2999] == boot[0].Replicates[2999] {
+		t.Error("another seed gave the same replicates")
+	}
+}
+
+func TestJackknifeMean(t *testing.T) {
+	x := sample(30, 5, 3)
+	est, err := resample.Jackknife(x, resample.Mean, resample.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// For the mean the jackknife standard error is exactly the classical sd / sqrt(n), and there is no bias.
+	m := resample.Mean(x)
+	ss := 0.0
+	for _, v := range x {
+		ss += (v - m) * (v - m)
+	}
+	want := math.Sqrt(ss/float64(len(x)-1)) / math.Sqrt(float64(len(x)))
+	if math.Abs(est.StdErr-want) > 1e-12 {
+		t.Errorf("StdErr = %v, want %v", est.StdErr, want)
+	}
+	if math.Abs(est.Bias) > 1e-12 || est.Value != m {
+		t.Errorf("Value = %v, Bias = %v, want %v and 0", est.Value, est.Bias, m)
+	}
+	if len(est.Replicates) != len(x) {
+		t.Errorf("%d replicates, want %d", len(est.Replicates), len(x))
+	}
+}
+
+func TestBootstrapMean(t *testing.T) {
+	x := sample(100, 5, 4)
+	est, err := resample.Bootstrap(x, resample.Mean, resample.Config{Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jack, err := resample.Jackknife(x, resample.Mean, resample.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The bootstrap standard error of the mean is sd / sqrt(n) up to a factor sqrt((n - 1) / n) and the resampling noise.
+	if math.Abs(est.StdErr/jack.StdErr-1) > 0.05 {
+		t.Errorf("StdErr = %v, want about %v", est.StdErr, jack.StdErr)
+	}
+	if !(est.Interval.Lo < est.Value && est.Value < est.Interval.Hi) {
+		t.Errorf("the interval %v doesn't hold the value %v", est.Interval, est.Value)
+	}
+}
+
+func TestPermutationTest(t *testing.T) {
+	x, y := sample(30, 0, 5), sample(30, 2, 6)
+	res, err := resample.PermutationTest(x, y, resample.MeanDiff, resample.Config{Resamples: 2000, Seed: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.PValue != 1.0/2001 {
+		t.Errorf("PValue = %v for samples 2 standard deviations apart, want 1/2001", res.PValue)
+	}
+	res, err = resample.PermutationTest(x, y, resample.MeanDiff, resample.Config{Resamples: 2000, Seed: 1, Alternative: resample.Greater})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.PValue < 0.99 {
+		t.Errorf("PValue = %v for x greater than y, which is much less", res.PValue)
+	}
+	if _, err := resample.PermutationTest(nil, y, resample.MeanDiff, resample.Config{}); !errors.Is(err, random.ErrEmpty) {
+		t.Errorf("PermutationTest of an empty sample error = %v, want ErrEmpty", err)
+	}
+}
@@ -0,0 +1,317 @@
+/*
+ * File: reservoir.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"bufio"
+	"container/heap"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// Reservoir picks k items uniformly without replacement from a stream of unknown length with Algorithm R.
+// Every item added costs one random number, see ReservoirL for long streams.
+// A Reservoir is not safe for concurrent use.
+type Reservoir[T any] struct {
+	Rand *rand.Rand // the generator used, nil uses Default()
+
+	k     int
+	n     int64
+	items []T
+}
+
+// NewReservoir function is used to get a Reservoir of k items.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the reservoir of type *Reservoir[T] and any write error encountered.
+// example: random.NewReservoir[string](10, nil), returns a reservoir keeping 10 of the strings added to it.
+func NewReservoir[T any](k int, r *rand.Rand) (*Reservoir[T], error) {
+	if k < 1 {
+		return nil, &Error{"NewReservoir", fmt.Errorf("%w: k must be positive", ErrInvalid)}
+	}
+	return &Reservoir[T]{Rand: r, k: k, items: make([]T, 0, k)}, nil
+}
+
+// Add adds x to the stream.
+func (s *Reservoir[T]) Add(x T) {
+	s.n++
+	if len(s.items) < s.k {
+		s.items = append(s.items, x)
+		return
+	}
+	// x replaces a random item with a probability of k / n.
+	if i := rng(s.Rand).Int63n(s.n); i < int64(s.k) {
+		s.items[i] = x
+	}
+}
+
+// Count returns the number of items added so far.
+func (s *Reservoir[T]) Count() int64 {
+	return s.n
+}
+
+// Result returns a copy of the picked items, which are all the items if less than k were added.
+// The items are in no particular order, shuffle them if the order matters.
+func (s *Reservoir[T]) Result() []T {
+	return append([]T(nil), s.items...)
+}
+
+// ReservoirL picks k items uniformly without replacement from a stream of unknown length with Algorithm L.
+// Instead of drawing for every item it draws how many items to skip, so it needs about k * (1 + log(n / k))
+// random numbers for a stream of n items, which is much faster than Reservoir for long streams.
+// A ReservoirL is not safe for concurrent use.
+type ReservoirL[T any] struct {
+	Rand *rand.Rand // the generator used, nil uses Default()
+
+	k     int
+	n     int64
+	next  int64   // the number of the next item which goes in the reservoir
+	w     float64 // the largest key of the reservoir in Algorithm L
+	items []T
+}
+
+// NewReservoirL function is used to get a ReservoirL of k items.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the reservoir of type *ReservoirL[T] and any write error encountered.
+// example: random.NewReservoirL[int](100, nil), returns a reservoir keeping 100 of the integers added to it.
+func NewReservoirL[T any](k int, r *rand.Rand) (*ReservoirL[T], error) {
+	if k < 1 {
+		return nil, &Error{"NewReservoirL", fmt.Errorf("%w: k must be positive", ErrInvalid)}
+	}
+	return &ReservoirL[T]{Rand: r, k: k, items: make([]T, 0, k)}, nil
+}
+
+// Add adds x to the stream.
+func (s *ReservoirL[T]) Add(x T) {
+	s.n++
+	if len(s.items) < s.k {
+		s.items = append(s.items, x)
+		if len(s.items) == s.k {
+			s.w = math.Exp(math.Log(openFloat64(rng(s.Rand))) / float64(s.k))
+			s.skip()
+		}
+		return
+	}
+	if s.n < s.next {
+		return
+	}
+	r := rng(s.Rand)
+	s.items[r.Intn(s.k)] = x
+	s.w *= math.Exp(math.Log(openFloat64(r)) / float64(s.k))
+	s.skip()
+}
+
+// skip is one of the inner functions of this package.
+// It draws the number of the next item which goes in the reservoir.
+func (s *ReservoirL[T]) skip() {
+	gap := math.Floor(math.Log(openFloat64(rng(s.Rand))) / math.Log1p(-s.w))
+	if gap >= math.MaxInt64-float64(s.n) || math.IsNaN(gap) {
+		s.next = math.MaxInt64
+		return
+	}
+	s.next = s.n + int64(gap) + 1
+}
+
+// Count returns the number of items added so far.
+func (s *ReservoirL[T]) Count() int64 {
+	return s.n
+}
+
+// Result returns a copy of the picked items, which are all the items if less than k were added.
+// The items are in no particular order, shuffle them if the order matters.
+func (s *ReservoirL[T]) Result() []T {
+	return append([]T(nil), s.items...)
+}
+
+// WeightedReservoir picks k items without replacement from a stream of unknown length,
+// the chance of an item to be picked is proportional to its weight, as drawn one at a time by weight.
+// It uses Algorithm A-ExpJ of Efraimidis and Spirakis, which gives the same result as A-Res (keeping the items
+// with the largest keys u^(1/w)) but draws random numbers only for about k * log(n / k) items.
+// Items with a weight which isn't positive are never picked.
+// A WeightedReservoir is not safe for concurrent use.
+type WeightedReservoir[T any] struct {
+	Rand *rand.Rand // the generator used, nil uses Default()
+
+	k    int
+	n    int64
+	skip float64 // the weight left to skip before the next item which goes in the reservoir
+	h    keyHeap[T]
+}
+
+// keyed is an item of a WeightedReservoir along with the log of its key.
+type keyed[T any] struct {
+	item T
+	key  float64
+}
+
+// keyHeap is a min-heap of keyed items, it implements heap.Interface.
+type keyHeap[T any] []keyed[T]
+
+func (h keyHeap[T]) Len() int            { return len(h) }
+func (h keyHeap[T]) Less(i, j int) bool  { return h[i].key < h[j].key }
+func (h keyHeap[T]) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *keyHeap[T]) Push(x interface{}) { *h = append(*h, x.(keyed[T])) }
+func (h *keyHeap[T]) Pop() interface{} {
+	old := *h
+	x := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return x
+}
+
+// NewWeightedReservoir function is used to get a WeightedReservoir of k items.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the reservoir of type *WeightedReservoir[T] and any write error encountered.
+// example: random.NewWeightedReservoir[string](5, nil), returns a reservoir keeping 5 of the strings added to it by weight.
+func NewWeightedReservoir[T any](k int, r *rand.Rand) (*WeightedReservoir[T], error) {
+	if k < 1 {
+		return nil, &Error{"NewWeightedReservoir", fmt.Errorf("%w: k must be positive", ErrInvalid)}
+	}
+	return &WeightedReservoir[T]{Rand: r, k: k, h: make(keyHeap[T], 0, k)}, nil
+}
+
+// Add adds x with the given weight to the stream.
+func (s *WeightedReservoir[T]) Add(x T, weight float64) {
+	if !(weight > 0) || math.IsInf(weight, 1) {
+		return
+	}
+	s.n++
+	r := rng(s.Rand)
+	// The keys are kept as logs, log(u^(1/w)) = log(u) / w, which doesn't underflow for small weights.
+	if len(s.h) < s.k {
+		heap.Push(&s.h, keyed[T]{x, math.Log(openFloat64(r)) / weight})
+		if len(s.h) == s.k {
+			s.drawSkip(r)
+		}
+		return
+	}
+	if s.skip -= weight; s.skip > 0 {
+		return
+	}
+	// The new key is drawn from the range above the smallest key, t = exp(min * w) is the smallest u it can have.
+	t := math.Exp(s.h[0].key * weight)
+	s.h[0] = keyed[T]{x, math.Log(t+(1-t)*openFloat64(r)) / weight}
+	heap.Fix(&s.h, 0)
+	s.drawSkip(r)
+}
+
+// drawSkip is one of the inner functions of this package.
+// It draws the weight to skip before the next item which goes in the reservoir.
+func (s *WeightedReservoir[T]) drawSkip(r *rand.Rand) {
+	s.skip = math.Log(openFloat64(r)) / s.h[0].key
+}
+
+// Count returns the number of items with a positive weight added so far.
+func (s *WeightedReservoir[T]) Count() int64 {
+	return s.n
+}
+
+// Result returns a copy of the picked items, which are all the items if less than k were added.
+// The items are ordered by their keys, which is the order of drawing them one at a time by weight.
+func (s *WeightedReservoir[T]) Result() []T {
+	h := append(keyHeap[T](nil), s.h...)
+	sort.Slice(h, func(i, j int) bool {
+		return h[i].key > h[j].key
+	})
+	items := make([]T, len(h))
+	for i := range h {
+		items[i] = h[i].item
+	}
+	return items
+}
+
+// SampleIter function is used to pick k items uniformly without replacement from an iterator, like a database cursor.
+// next returns the next item and true, or false once there are no items left.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the picked items in a slice of type []T and any write error encountered.
+// example: random.SampleIter(rows.Next, 10, nil), returns 10 random rows.
+func SampleIter[T any](next func() (T, bool), k int, r *rand.Rand) ([]T, error) {
+	s, err := NewReservoirL[T](k, r)
+	if err != nil {
+		return nil, err
+	}
+	for x, ok := next(); ok; x, ok = next() {
+		s.Add(x)
+	}
+	return s.Result(), nil
+}
+
+// SampleChan function is used to pick k values uniformly without replacement from a channel.
+// It reads ch until it is closed.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the picked values in a slice of type []T and any write error encountered.
+// example: random.SampleChan(events, 100, nil), returns 100 random events once events is closed.
+func SampleChan[T any](ch <-chan T, k int, r *rand.Rand) ([]T, error) {
+	s, err := NewReservoirL[T](k, r)
+	if err != nil {
+		return nil, err
+	}
+	for x := range ch {
+		s.Add(x)
+	}
+	return s.Result(), nil
+}
+
+// SampleScanner function is used to pick k tokens uniformly without replacement from a bufio.Scanner, like lines of a log.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the picked tokens in a slice of type []string and any read error of the scanner.
+// example: random.SampleScanner(bufio.NewScanner(file), 20, nil), returns 20 random lines of file.
+func SampleScanner(sc *bufio.Scanner, k int, r *rand.Rand) ([]string, error) {
+	const fn = "SampleScanner"
+	s, err := NewReservoirL[string](k, r)
+	if err != nil {
+		return nil, err
+	}
+	for sc.Scan() {
+		s.Add(sc.Text())
+	}
+	if err := sc.Err(); err != nil {
+		return nil, &Error{fn, err}
+	}
+	return s.Result(), nil
+}
+
+// SampleWeightedIter function is used to pick k items without replacement by weight from an iterator.
+// next returns the next item, its weight and true, or false once there are no items left.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the picked items in a slice of type []T, ordered as drawn one at a time by weight, and any write error encountered.
+// example: random.SampleWeightedIter(next, 3, nil), returns 3 items where heavier ones are more likely.
+func SampleWeightedIter[T any](next func() (T, float64, bool), k int, r *rand.Rand) ([]T, error) {
+	s, err := NewWeightedReservoir[T](k, r)
+	if err != nil {
+		return nil, err
+	}
+	for x, w, ok := next(); ok; x, w, ok = next() {
+		s.Add(x, w)
+	}
+	return s.Result(), nil
+}
+
+// openFloat64 is one of the inner functions of this package.
+// It returns a uniform float64 in the range (0, 1), which is safe to take the log of.
+func openFloat64(r *rand.Rand) float64 {
+	for {
+		if u := r.Float64(); u > 0 {
+			return u
+		}
+	}
+}
@@ -0,0 +1,235 @@
+/*
+ * File: reservoir_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random_test
+
+import (
+	"bufio"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/anonyindian/random-go"
+	"github.com/anonyindian/random-go/randtest"
+)
+
+// checkInclusion samples k of the items [0, n) drawsPerOutcome*n/k times and checks that every item is picked
+// equally often. The counts of a sample without replacement vary less than multinomial ones, so the test is conservative.
+func checkInclusion(t *testing.T, name string, n int, k int, sample func(items []int) []int) {
+	t.Helper()
+	items := make([]int, n)
+	for i := range items {
+		items[i] = i
+	}
+	counts := make([]int, n)
+	for i := 0; i < drawsPerOutcome*n/k; i++ {
+		s := sample(items)
+		if len(s) != k {
+			t.Fatalf("%s picked %d items, want %d", name, len(s), k)
+		}
+		seen := make(map[int]bool, k)
+		for _, x := range s {
+			if x < 0 || x >= n || seen[x] {
+				t.Fatalf("%s picked %v, which is not a sample without replacement", name, s)
+			}
+			seen[x] = true
+			counts[x]++
+		}
+	}
+	res, err := randtest.ChiSquareUniform(counts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Passed(alpha) {
+		t.Errorf("%s is not uniform: chi-square %g, p-value %g, counts %v", name, res.Statistic, res.PValue, counts)
+	}
+}
+
+func TestReservoirUniform(t *testing.T) {
+	for _, k := range []int{1, 5} {
+		r := random.NewRand(1)
+		checkInclusion(t, "Reservoir", 20, k, func(items []int) []int {
+			s, _ := random.NewReservoir[int](k, r)
+			for _, x := range items {
+				s.Add(x)
+			}
+			if s.Count() != int64(len(items)) {
+				t.Fatalf("Count() = %d, want %d", s.Count(), len(items))
+			}
+			return s.Result()
+		})
+		r = random.NewRand(2)
+		checkInclusion(t, "ReservoirL", 20, k, func(items []int) []int {
+			s, _ := random.NewReservoirL[int](k, r)
+			for _, x := range items {
+				s.Add(x)
+			}
+			if s.Count() != int64(len(items)) {
+				t.Fatalf("Count() = %d, want %d", s.Count(), len(items))
+			}
+			return s.Result()
+		})
+	}
+}
+
+func TestSampleHelpers(t *testing.T) {
+	const k = 4
+	r := random.NewRand(3)
+	checkInclusion(t, "SampleIter", 12, k, func(items []int) []int {
+		i := 0
+		s, err := random.SampleIter(func() (int, bool) {
+			if i == len(items) {
+				return 0, false
+			}
+			i++
+			return items[i-1], true
+		}, k, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	})
+	r = random.NewRand(4)
+	checkInclusion(t, "SampleChan", 12, k, func(items []int) []int {
+		ch := make(chan int, len(items))
+		for _, x := range items {
+			ch <- x
+		}
+		close(ch)
+		s, err := random.SampleChan(ch, k, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	})
+	r = random.NewRand(5)
+	checkInclusion(t, "SampleScanner", 12, k, func(items []int) []int {
+		var b strings.Builder
+		for _, x := range items {
+			b.WriteString(strconv.Itoa(x) + "\n")
+		}
+		lines, err := random.SampleScanner(bufio.NewScanner(strings.NewReader(b.String())), k, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s := make([]int, len(lines))
+		for i, l := range lines {
+			s[i], _ = strconv.Atoi(l)
+		}
+		return s
+	})
+	if s, err := random.SampleIter(func() (int, bool) { return 0, false }, k, nil); err != nil || len(s) != 0 {
+		t.Errorf("SampleIter of no items = %v, %v", s, err)
+	}
+}
+
+// checkWeighted draws ordered pairs of the items [0, len(weights)) with sample and checks their frequencies against
+// drawing the items one at a time by weight, P(i, j) = w[i]/W * w[j]/(W-w[i]).
+func checkWeighted(t *testing.T, name string, weights []float64, sample func() []int) {
+	t.Helper()
+	n := len(weights)
+	total := 0.0
+	for _, w := range weights {
+		total += w
+	}
+	draws := drawsPerOutcome * n * (n - 1)
+	observed := make([]int, n*n)
+	expected := make([]float64, n*n)
+	for i := range weights {
+		for j := range weights {
+			if i != j {
+				expected[i*n+j] = float64(draws) * weights[i] / total * weights[j] / (total - weights[i])
+			}
+		}
+	}
+	for d := 0; d < draws; d++ {
+		s := sample()
+		if len(s) != 2 || s[0] == s[1] {
+			t.Fatalf("%s picked %v, want 2 distinct items", name, s)
+		}
+		observed[s[0]*n+s[1]]++
+	}
+	// Drop the impossible pairs of an item with itself.
+	var obs []int
+	var exp []float64
+	for i := range observed {
+		if i/n != i%n {
+			obs = append(obs, observed[i])
+			exp = append(exp, expected[i])
+		}
+	}
+	res, err := randtest.ChiSquare(obs, exp)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !res.Passed(alpha) {
+		t.Errorf("%s doesn't follow the weights: chi-square %g, p-value %g, counts %v", name, res.Statistic, res.PValue, obs)
+	}
+}
+
+func TestWeightedReservoir(t *testing.T) {
+	weights := []float64{1, 2, 3, 4, 6}
+	r := random.NewRand(6)
+	checkWeighted(t, "WeightedReservoir", weights, func() []int {
+		s, _ := random.NewWeightedReservoir[int](2, r)
+		for i, w := range weights {
+			s.Add(i, w)
+		}
+		// Items without a positive weight are never picked.
+		s.Add(-1, 0)
+		s.Add(-1, -3)
+		if s.Count() != int64(len(weights)) {
+			t.Fatalf("Count() = %d, want %d", s.Count(), len(weights))
+		}
+		return s.Result()
+	})
+	r = random.NewRand(7)
+	checkWeighted(t, "SampleWeightedIter", weights, func() []int {
+		i := 0
+		s, err := random.SampleWeightedIter(func() (int, float64, bool) {
+			if i == len(weights) {
+				return 0, 0, false
+			}
+			i++
+			return i - 1, weights[i-1], true
+		}, 2, r)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	})
+}
+
+func TestReservoirInvalid(t *testing.T) {
+	if _, err := random.NewReservoir[int](0, nil); !errors.Is(err, random.ErrInvalid) {
+		t.Errorf("NewReservoir(0) error = %v, want ErrInvalid", err)
+	}
+	if _, err := random.NewReservoirL[int](-1, nil); !errors.Is(err, random.ErrInvalid) {
+		t.Errorf("NewReservoirL(-1) error = %v, want ErrInvalid", err)
+	}
+	if _, err := random.NewWeightedReservoir[int](0, nil); !errors.Is(err, random.ErrInvalid) {
+		t.Errorf("NewWeightedReservoir(0) error = %v, want ErrInvalid", err)
+	}
+	if _, err := random.SampleChan(make(chan int), 0, nil); !errors.Is(err, random.ErrInvalid) {
+		t.Errorf("SampleChan(k = 0) error = %v, want ErrInvalid", err)
+	}
+}
@@ -22,6 +22,7 @@
 package random
 
 import (
+	"fmt"
 	"math/rand"
 )
 
@@ -167,3 +168,56 @@ func ShuffleFloat64(a []float64) []float64 {
 	})
 	return a
 }
+
+// ShuffledCopy pseudo-randomizes the order of a copy of the provided slice, a itself is left untouched.
+// parameter 'a' can be a slice of any type.
+// It returns the shuffled copy of type []T.
+// example: random.ShuffledCopy([]string{"abc", "hello", "nice"}), returns a new shuffled slice (type []string).
+func ShuffledCopy[T any](a []T) []T {
+	c := make([]T, len(a))
+	copy(c, a)
+	rand.Shuffle(len(c), func(i, j int) {
+		c[i], c[j] = c[j], c[i]
+	})
+	return c
+}
+
+// ShuffleK pseudo-randomizes only the first k positions of the provided slice, in place and in O(k) time.
+// The first k elements become a uniform random sample of a in random order, the rest of a holds the other elements.
+// parameter 'a' can be a slice of any type.
+// It returns the first k elements in a slice of type []T and any write error encountered.
+// example: random.ShuffleK([]int{1, 2, 3, 4, 5, 6}, 2), returns 2 random elements like [5 2] (type []int).
+func ShuffleK[T any](a []T, k int) ([]T, error) {
+	const fn = "ShuffleK"
+	if k < 0 {
+		return nil, &Error{fn, fmt.Errorf("%w: k must not be negative", ErrInvalid)}
+	}
+	if k > len(a) {
+		return nil, &Error{fn, ErrExceed}
+	}
+	for i := 0; i < k; i++ {
+		j := i + rand.Intn(len(a)-i)
+		a[i], a[j] = a[j], a[i]
+	}
+	return a[:k], nil
+}
+
+// ShuffleRange pseudo-randomizes the order of the elements of the sub-range a[i:j], in place.
+// The elements outside the range keep their positions.
+// parameter 'a' can be a slice of any type.
+// It returns the slice a of type []T and any write error encountered.
+// example: random.ShuffleRange([]int{1, 2, 3, 4, 5, 6}, 1, 4), returns a slice like [1 4 2 3 5 6] (type []int).
+func ShuffleRange[T any](a []T, i int, j int) ([]T, error) {
+	const fn = "ShuffleRange"
+	if i < 0 || j > len(a) {
+		return nil, &Error{fn, fmt.Errorf("%w: range [%d, %d) out of bounds of a slice of length %d", ErrInvalid, i, j, len(a))}
+	}
+	if i > j {
+		return nil, &Error{fn, ErrEndNumSmaller}
+	}
+	s := a[i:j]
+	rand.Shuffle(len(s), func(x, y int) {
+		s[x], s[y] = s[y], s[x]
+	})
+	return a, nil
+}
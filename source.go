@@ -0,0 +1,90 @@
+/*
+ * File: source.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	crand "crypto/rand"
+	"encoding/binary"
+	"math/rand"
+)
+
+// globalSource is a rand.Source64 backed by the top-level math/rand functions.
+// It is safe for concurrent use and shares its state with Choice, Integer, Shuffle, etc.
+type globalSource struct{}
+
+func (globalSource) Int63() int64   { return rand.Int63() }
+func (globalSource) Uint64() uint64 { return rand.Uint64() }
+func (globalSource) Seed(int64)     {}
+
+var defaultRand = rand.New(globalSource{})
+
+// Default returns the generator which is used wherever a nil *rand.Rand is accepted by this package.
+// It draws from the same source as the top-level functions like Choice, Integer and Shuffle.
+// Its Read method must not be called concurrently, all the other methods are safe for concurrent use.
+func Default() *rand.Rand {
+	return defaultRand
+}
+
+// NewRand returns a new generator of type *rand.Rand seeded with the given seed.
+// Generators created with the same seed produce the same values, which makes the output reproducible.
+// example: random.NewRand(42), returns a generator which will always yield the same sequence.
+func NewRand(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
+}
+
+// SecureSource is a rand.Source64 which reads from crypto/rand, the operating system's secure random generator.
+// It can't be seeded, use it with rand.New or NewSecureRand for anything which must not be guessable, like passwords and tokens.
+// It is safe for concurrent use.
+type SecureSource struct{}
+
+// Int63 returns a non-negative random int64 read from crypto/rand.
+func (s SecureSource) Int63() int64 {
+	return int64(s.Uint64() >> 1)
+}
+
+// Uint64 returns a random uint64 read from crypto/rand.
+// It panics if crypto/rand fails, which never happens on the supported platforms.
+func (SecureSource) Uint64() uint64 {
+	var b [8]byte
+	if _, err := crand.Read(b[:]); err != nil {
+		panic("random: crypto/rand failed: " + err.Error())
+	}
+	return binary.LittleEndian.Uint64(b[:])
+}
+
+// Seed does nothing, a secure source can't be seeded.
+func (SecureSource) Seed(int64) {}
+
+// NewSecureRand returns a new generator of type *rand.Rand backed by SecureSource.
+// example: random.NewSecureRand().Intn(10), returns a secure random integer from the range [0, 10).
+func NewSecureRand() *rand.Rand {
+	return rand.New(SecureSource{})
+}
+
+// rng is one of the inner functions of this package.
+// It returns r, or the default generator if r is nil.
+func rng(r *rand.Rand) *rand.Rand {
+	if r == nil {
+		return defaultRand
+	}
+	return r
+}
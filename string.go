@@ -0,0 +1,208 @@
+/*
+ * File: string.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Alphabet is a set of runes which random strings are made of.
+// It can hold multi-byte runes, the generated strings are always valid UTF-8.
+type Alphabet []rune
+
+// Predefined alphabets for String and UniformString.
+var (
+	AlphabetDigits    = Alphabet("0123456789")
+	AlphabetLower     = Alphabet("abcdefghijklmnopqrstuvwxyz")
+	AlphabetUpper     = Alphabet("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	AlphabetAlnum     = Alphabet("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789")
+	AlphabetHex       = Alphabet("0123456789abcdef")
+	AlphabetBase32    = Alphabet("ABCDEFGHIJKLMNOPQRSTUVWXYZ234567")                                 // RFC 4648 base32
+	AlphabetURLSafe   = Alphabet("ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_") // RFC 4648 base64url
+	AlphabetCrockford = Alphabet("0123456789ABCDEFGHJKMNPQRSTVWXYZ")                                 // Crockford's base32
+	AlphabetSymbols   = Alphabet("!#$%&*+-=?@^_~")
+)
+
+// NewAlphabet function is used to get an Alphabet from the distinct runes of s.
+// It returns the alphabet of type Alphabet and any write error encountered.
+// example: random.NewAlphabet("αβγδ"), returns an alphabet of 4 greek letters.
+func NewAlphabet(s string) (Alphabet, error) {
+	const fn = "NewAlphabet"
+	if !utf8.ValidString(s) {
+		return nil, &Error{fn, fmt.Errorf("%w: s is not valid UTF-8", ErrInvalid)}
+	}
+	seen := make(map[rune]bool)
+	var a Alphabet
+	for _, c := range s {
+		if !seen[c] {
+			seen[c] = true
+			a = append(a, c)
+		}
+	}
+	if len(a) == 0 {
+		return nil, &Error{fn, ErrEmpty}
+	}
+	return a, nil
+}
+
+// UnicodeRange function is used to get an Alphabet of all the valid runes in the range [lo, hi].
+// Surrogate halves, which can't be encoded in UTF-8, are left out.
+// It returns the alphabet of type Alphabet and any write error encountered.
+// example: random.UnicodeRange(0x0400, 0x04FF), returns the cyrillic block.
+func UnicodeRange(lo rune, hi rune) (Alphabet, error) {
+	const fn = "UnicodeRange"
+	if lo > hi {
+		return nil, &Error{fn, ErrEndNumSmaller}
+	}
+	var a Alphabet
+	for c := lo; c <= hi && c <= unicode.MaxRune; c++ {
+		if utf8.ValidRune(c) {
+			a = append(a, c)
+		}
+	}
+	if len(a) == 0 {
+		return nil, &Error{fn, ErrEmpty}
+	}
+	return a, nil
+}
+
+// UnicodeTables function is used to get an Alphabet of all the runes in the given unicode tables.
+// example: random.UnicodeTables(unicode.Greek, unicode.Hiragana), returns an alphabet of greek and hiragana letters.
+func UnicodeTables(tables ...*unicode.RangeTable) Alphabet {
+	var a Alphabet
+	for _, t := range tables {
+		for _, r := range t.R16 {
+			for c := rune(r.Lo); c <= rune(r.Hi); c += rune(r.Stride) {
+				a = append(a, c)
+			}
+		}
+		for _, r := range t.R32 {
+			for c := rune(r.Lo); c <= rune(r.Hi); c += rune(r.Stride) {
+				a = append(a, c)
+			}
+		}
+	}
+	return a
+}
+
+// UniformString is a Distribution of strings whose runes are drawn uniformly from an Alphabet.
+// The length in runes of every string is drawn uniformly from the range [MinLength, MaxLength].
+type UniformString struct {
+	Alphabet  Alphabet   // the runes the strings are made of
+	MinLength int        // the shortest length in runes
+	MaxLength int        // the longest length in runes
+	Rand      *rand.Rand // the generator used for sampling, nil uses Default()
+}
+
+// NewUniformString function is used to get a Distribution of strings with a length in the range [minLength, maxLength].
+// minLength may be equal to maxLength for strings of a fixed length.
+// It returns the distribution of type *UniformString and any write error encountered.
+// example: random.NewUniformString(8, 12, random.AlphabetAlnum), returns a distribution of alphanumeric strings of 8 to 12 characters.
+func NewUniformString(minLength int, maxLength int, alphabet Alphabet) (*UniformString, error) {
+	const fn = "NewUniformString"
+	if len(alphabet) == 0 {
+		return nil, &Error{fn, ErrEmpty}
+	}
+	if minLength < 0 || minLength > maxLength {
+		return nil, &Error{fn, fmt.Errorf("%w: need 0 <= minLength <= maxLength", ErrInvalid)}
+	}
+	return &UniformString{Alphabet: alphabet, MinLength: minLength, MaxLength: maxLength}, nil
+}
+
+// Sample draws a random string.
+func (s *UniformString) Sample() string {
+	var b strings.Builder
+	s.write(&b, rng(s.Rand))
+	return b.String()
+}
+
+// SampleN draws n random strings.
+// All the strings are written into one buffer, which saves an allocation for every string.
+func (s *UniformString) SampleN(n int) []string {
+	r := rng(s.Rand)
+	var b strings.Builder
+	if size := n * (s.MinLength + s.MaxLength) / 2 * utf8.RuneLen(s.Alphabet[0]); size > 0 {
+		b.Grow(size)
+	}
+	ends := make([]int, n)
+	for i := range ends {
+		s.write(&b, r)
+		ends[i] = b.Len()
+	}
+	all := b.String()
+	out := make([]string, n)
+	start := 0
+	for i, end := range ends {
+		out[i] = all[start:end]
+		start = end
+	}
+	return out
+}
+
+// write is one of the inner functions of this package.
+// It writes a random string to b.
+func (s *UniformString) write(b *strings.Builder, r *rand.Rand) {
+	length := s.MinLength
+	if s.MaxLength > s.MinLength {
+		length += r.Intn(s.MaxLength - s.MinLength + 1)
+	}
+	for i := 0; i < length; i++ {
+		b.WriteRune(s.Alphabet[r.Intn(len(s.Alphabet))])
+	}
+}
+
+// String function is used to get a random string of length runes drawn from alphabet.
+// It returns the randomly generated string and any write error encountered.
+// example: random.String(16, random.AlphabetHex), returns a string of 16 random hexadecimal digits.
+func String(length int, alphabet Alphabet) (string, error) {
+	s, err := NewUniformString(length, length, alphabet)
+	if err != nil {
+		return "", err
+	}
+	return s.Sample(), nil
+}
+
+// StringRange function is used to get a random string drawn from alphabet with a length in the range [minLength, maxLength].
+// It returns the randomly generated string and any write error encountered.
+// example: random.StringRange(4, 8, random.AlphabetLower), returns a lowercase word of 4 to 8 letters.
+func StringRange(minLength int, maxLength int, alphabet Alphabet) (string, error) {
+	s, err := NewUniformString(minLength, maxLength, alphabet)
+	if err != nil {
+		return "", err
+	}
+	return s.Sample(), nil
+}
+
+// StringN function is used to get n random strings of length runes drawn from alphabet.
+// It returns the randomly generated strings in a slice of type []string and any write error encountered.
+// example: random.StringN(1000, 10, random.AlphabetAlnum), returns 1000 alphanumeric strings of 10 characters.
+func StringN(n int, length int, alphabet Alphabet) ([]string, error) {
+	s, err := NewUniformString(length, length, alphabet)
+	if err != nil {
+		return nil, err
+	}
+	return s.SampleN(n), nil
+}
@@ -0,0 +1,150 @@
+/*
+ * File: lorem.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+
+// Package text generates random text, lorem ipsum for UI fixtures and Markov-chain text which imitates a corpus.
+// Like the rest of the random package, every generator takes a *rand.Rand so that its output can be reproduced from a seed.
+package text
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/anonyindian/random-go"
+)
+
+// loremWords is the vocabulary of Lorem.
+var loremWords = strings.Fields(`lorem ipsum dolor sit amet consectetur adipiscing elit sed do eiusmod tempor
+incididunt ut labore et dolore magna aliqua enim ad minim veniam quis nostrud exercitation ullamco laboris nisi
+aliquip ex ea commodo consequat duis aute irure in reprehenderit voluptate velit esse cillum eu fugiat nulla
+pariatur excepteur sint occaecat cupidatat non proident sunt culpa qui officia deserunt mollit anim id est laborum
+at vero eos accusamus iusto odio dignissimos ducimus blanditiis praesentium voluptatum deleniti atque corrupti quos
+dolores quas molestias excepturi obcaecati cupiditate provident similique mollitia animi dolorum fuga harum quidem
+rerum facilis expedita distinctio nam libero tempore cum soluta nobis eligendi optio cumque nihil impedit quo minus
+quod maxime placeat facere possimus omnis voluptas assumenda repellendus temporibus autem quibusdam officiis debitis
+aut necessitatibus saepe eveniet voluptates repudiandae recusandae itaque earum hic tenetur sapiente delectus
+reiciendis voluptatibus maiores alias consequatur perferendis doloribus asperiores repellat`)
+
+// Lorem generates lorem ipsum placeholder text: words, sentences and paragraphs.
+// The zero value is ready to use.
+type Lorem struct {
+	Rand *rand.Rand // the generator used, nil uses random.Default()
+}
+
+// Words function returns n random lorem ipsum words separated by spaces and any write error encountered.
+// example: l.Words(3), returns a string like "dolor quis tempor".
+func (l *Lorem) Words(n int) (string, error) {
+	const fn = "Lorem.Words"
+	if n < 0 {
+		return "", &random.Error{Func: fn, Err: fmt.Errorf("%w: n must not be negative", random.ErrInvalid)}
+	}
+	r := l.rand()
+	w := make([]string, n)
+	for i := range w {
+		w[i] = loremWords[r.Intn(len(loremWords))]
+	}
+	return strings.Join(w, " "), nil
+}
+
+// Sentence function returns a random lorem ipsum sentence of 4 to 16 words,
+// starting with a capital letter and ending with a full stop.
+func (l *Lorem) Sentence() string {
+	r := l.rand()
+	var b strings.Builder
+	n := 4 + r.Intn(13)
+	for i := 0; i < n; i++ {
+		w := loremWords[r.Intn(len(loremWords))]
+		if i == 0 {
+			w = strings.ToUpper(w[:1]) + w[1:]
+		} else {
+			b.WriteByte(' ')
+		}
+		b.WriteString(w)
+		if i > 2 && i < n-2 && r.Intn(8) == 0 {
+			b.WriteByte(',')
+		}
+	}
+	b.WriteByte('.')
+	return b.String()
+}
+
+// Sentences function returns n random lorem ipsum sentences separated by spaces and any write error encountered.
+func (l *Lorem) Sentences(n int) (string, error) {
+	const fn = "Lorem.Sentences"
+	if n < 0 {
+		return "", &random.Error{Func: fn, Err: fmt.Errorf("%w: n must not be negative", random.ErrInvalid)}
+	}
+	return l.sentences(n), nil
+}
+
+// sentences is one of the inner functions of this package.
+// It returns n random lorem ipsum sentences separated by spaces.
+func (l *Lorem) sentences(n int) string {
+	s := make([]string, n)
+	for i := range s {
+		s[i] = l.Sentence()
+	}
+	return strings.Join(s, " ")
+}
+
+// Paragraph function returns a random lorem ipsum paragraph of 3 to 7 sentences.
+func (l *Lorem) Paragraph() string {
+	return l.sentences(3 + l.rand().Intn(5))
+}
+
+// Paragraphs function returns n random lorem ipsum paragraphs separated by blank lines and any write error encountered.
+func (l *Lorem) Paragraphs(n int) (string, error) {
+	const fn = "Lorem.Paragraphs"
+	if n < 0 {
+		return "", &random.Error{Func: fn, Err: fmt.Errorf("%w: n must not be negative", random.ErrInvalid)}
+	}
+	p := make([]string, n)
+	for i := range p {
+		p[i] = l.Paragraph()
+	}
+	return strings.Join(p, "\n\n"), nil
+}
+
+// rand is one of the inner functions of this package.
+// It returns the generator of l, or the default one of the random package.
+func (l *Lorem) rand() *rand.Rand {
+	if l.Rand == nil {
+		return random.Default()
+	}
+	return l.Rand
+}
+
+// Words function returns n random lorem ipsum words separated by spaces and any write error encountered.
+// example: text.Words(5), returns a string like "sit amet elit sed magna".
+func Words(n int) (string, error) {
+	return (&Lorem{}).Words(n)
+}
+
+// Sentences function returns n random lorem ipsum sentences separated by spaces and any write error encountered.
+func Sentences(n int) (string, error) {
+	return (&Lorem{}).Sentences(n)
+}
+
+// Paragraphs function returns n random lorem ipsum paragraphs separated by blank lines and any write error encountered.
+func Paragraphs(n int) (string, error) {
+	return (&Lorem{}).Paragraphs(n)
+}
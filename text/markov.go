@@ -0,0 +1,121 @@
+/*
+ * File: markov.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package text
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/rand"
+	"strings"
+
+	"github.com/anonyindian/random-go"
+)
+
+// Markov generates text which imitates a corpus, every word is drawn from the words which
+// followed the previous order words in the corpus, as often as they followed them.
+type Markov struct {
+	Rand *rand.Rand // the generator used, nil uses random.Default()
+
+	order  int
+	chain  map[string][]string // the words which followed every prefix, keyed by the prefix joined with spaces
+	starts [][]string          // the prefixes which start a sentence in the corpus
+}
+
+// NewMarkov function is used to train a Markov generator on the words read from r.
+// order (type int) is the number of previous words the next word depends on, higher orders copy longer passages of the corpus.
+// It returns the generator of type *Markov and any write error encountered.
+// example: text.NewMarkov(file, 2), returns a generator whose every word depends on the 2 words before it.
+func NewMarkov(r io.Reader, order int) (*Markov, error) {
+	const fn = "NewMarkov"
+	if order < 1 {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: order must be positive", random.ErrInvalid)}
+	}
+	m := &Markov{order: order, chain: make(map[string][]string)}
+	s := bufio.NewScanner(r)
+	s.Split(bufio.ScanWords)
+	prefix := make([]string, 0, order)
+	sentenceStart := true
+	for s.Scan() {
+		w := s.Text()
+		if len(prefix) == order {
+			key := strings.Join(prefix, " ")
+			m.chain[key] = append(m.chain[key], w)
+			copy(prefix, prefix[1:])
+			prefix[order-1] = w
+		} else {
+			prefix = append(prefix, w)
+		}
+		if len(prefix) == order && sentenceStart {
+			m.starts = append(m.starts, append([]string(nil), prefix...))
+			sentenceStart = false
+		}
+		if strings.ContainsAny(w[len(w)-1:], ".!?") {
+			// the next prefix of order words starts a sentence
+			sentenceStart = true
+			prefix = prefix[:0]
+		}
+	}
+	if err := s.Err(); err != nil {
+		return nil, &random.Error{Func: fn, Err: err}
+	}
+	if len(m.chain) == 0 {
+		return nil, &random.Error{Func: fn, Err: fmt.Errorf("%w: the corpus has no sentence of more than %d words", random.ErrEmpty, order)}
+	}
+	return m, nil
+}
+
+// Generate function returns text of the given number of words, separated by spaces, and any write error encountered.
+// It starts at the beginning of a random sentence of the corpus, and starts another one whenever the chain reaches a dead end.
+func (m *Markov) Generate(words int) (string, error) {
+	const fn = "Markov.Generate"
+	if words < 0 {
+		return "", &random.Error{Func: fn, Err: fmt.Errorf("%w: words must not be negative", random.ErrInvalid)}
+	}
+	r := m.Rand
+	if r == nil {
+		r = random.Default()
+	}
+	out := make([]string, 0, words)
+	var prefix []string
+	for len(out) < words {
+		if prefix == nil {
+			prefix = append([]string(nil), m.starts[r.Intn(len(m.starts))]...)
+			for _, w := range prefix {
+				if len(out) < words {
+					out = append(out, w)
+				}
+			}
+			continue
+		}
+		next := m.chain[strings.Join(prefix, " ")]
+		if len(next) == 0 {
+			prefix = nil
+			continue
+		}
+		w := next[r.Intn(len(next))]
+		out = append(out, w)
+		copy(prefix, prefix[1:])
+		prefix[len(prefix)-1] = w
+	}
+	return strings.Join(out, " "), nil
+}
@@ -0,0 +1,64 @@
+/*
+ * File: text_test.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package text_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/anonyindian/random-go"
+	"github.com/anonyindian/random-go/text"
+)
+
+func TestNegativeCounts(t *testing.T) {
+	l := &text.Lorem{}
+	m, err := text.NewMarkov(strings.NewReader("the cat sat on the mat. the dog sat on the rug."), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, f := range map[string]func(int) (string, error){
+		"Words":      l.Words,
+		"Sentences":  l.Sentences,
+		"Paragraphs": l.Paragraphs,
+		"Generate":   m.Generate,
+	} {
+		if _, err := f(-1); !errors.Is(err, random.ErrInvalid) {
+			t.Errorf("%s(-1) error = %v, want ErrInvalid", name, err)
+		}
+		if s, err := f(0); err != nil || s != "" {
+			t.Errorf("%s(0) = %q, %v", name, s, err)
+		}
+	}
+}
+
+func TestWordCounts(t *testing.T) {
+	l := &text.Lorem{Rand: random.NewRand(1)}
+	if s, _ := l.Words(7); len(strings.Fields(s)) != 7 {
+		t.Errorf("Words(7) = %q", s)
+	}
+	m, _ := text.NewMarkov(strings.NewReader("the cat sat on the mat. the dog sat on the rug."), 2)
+	m.Rand = random.NewRand(1)
+	if s, _ := m.Generate(25); len(strings.Fields(s)) != 25 {
+		t.Errorf("Generate(25) = %q", s)
+	}
+}
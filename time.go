@@ -0,0 +1,229 @@
+/*
+ * File: time.go
+ * Created on Mon Oct 19 2026
+ *
+ * The MIT License (MIT)
+ * Copyright (c) 2021 Veer (anonyindian)
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a copy of this software
+ * and associated documentation files (the "Software"), to deal in the Software without restriction,
+ * including without limitation the rights to use, copy, modify, merge, publish, distribute, sublicense,
+ * and/or sell copies of the Software, and to permit persons to whom the Software is furnished to do so,
+ * subject to the following conditions:
+ *
+ * The above copyright notice and this permission notice shall be included in all copies or substantial
+ * portions of the Software.
+ *
+ * THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED
+ * TO THE WARRANTIES OF MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL
+ * THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+ * TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+ */
+package random
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Time function is used to get a random time between a range [start, end].
+// The result is in the location of start.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the randomly chosen time of type time.Time and any write error encountered.
+// example: random.Time(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), nil), returns any one instant of 2024.
+func Time(start time.Time, end time.Time, r *rand.Rand) (time.Time, error) {
+	const fn = "Time"
+	if !end.After(start) {
+		return time.Time{}, &Error{fn, ErrEndNumSmaller}
+	}
+	r = rng(r)
+	if d := end.Sub(start); d < math.MaxInt64 {
+		return start.Add(time.Duration(int64Range(0, int64(d), r))), nil
+	}
+	// The range is too long for a time.Duration, so the seconds and the nanoseconds are drawn apart.
+	for {
+		t := time.Unix(int64Range(start.Unix(), end.Unix(), r), r.Int63n(int64(time.Second))).In(start.Location())
+		if !t.Before(start) && !t.After(end) {
+			return t, nil
+		}
+	}
+}
+
+// Date function is used to get a random calendar day between the days of start and end, both included.
+// The result is midnight of the chosen day in the location of start.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the randomly chosen date of type time.Time and any write error encountered.
+// example: random.Date(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), nil), returns any one day of March 2024.
+func Date(start time.Time, end time.Time, r *rand.Rand) (time.Time, error) {
+	const fn = "Date"
+	loc := start.Location()
+	end = end.In(loc)
+	y0, m0, d0 := start.Date()
+	y1, m1, d1 := end.Date()
+	// Counting the days in UTC keeps daylight saving time changes out of the way.
+	days := int64(time.Date(y1, m1, d1, 0, 0, 0, 0, time.UTC).Sub(time.Date(y0, m0, d0, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+	if days <= 0 {
+		return time.Time{}, &Error{fn, ErrEndNumSmaller}
+	}
+	return time.Date(y0, m0, d0+int(int64Range(0, days, rng(r))), 0, 0, 0, 0, loc), nil
+}
+
+// WeekdayTime function is used to get a random time between a range [start, end) which falls on a Monday to Friday,
+// in the location of start.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the randomly chosen time of type time.Time and any write error encountered, ErrEmpty if the range holds no weekday.
+// example: random.WeekdayTime(start, start.AddDate(0, 1, 0), nil), returns any one instant of the working days of the next month.
+func WeekdayTime(start time.Time, end time.Time, r *rand.Rand) (time.Time, error) {
+	t, err := timeInHours(start, end, 0, 24, r)
+	if err != nil {
+		return time.Time{}, &Error{"WeekdayTime", err}
+	}
+	return t, nil
+}
+
+// BusinessTime function is used to get a random time between a range [start, end) which falls on a Monday to Friday
+// between openHour and closeHour, like 9 and 17, in the location of start.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the randomly chosen time of type time.Time and any write error encountered, ErrEmpty if the range holds no business hours.
+// example: random.BusinessTime(start, start.AddDate(0, 0, 7), 9, 17, nil), returns a time like Wednesday 14:23:08 of the next week.
+func BusinessTime(start time.Time, end time.Time, openHour int, closeHour int, r *rand.Rand) (time.Time, error) {
+	const fn = "BusinessTime"
+	if openHour < 0 || openHour >= closeHour || closeHour > 24 {
+		return time.Time{}, &Error{fn, fmt.Errorf("%w: need 0 <= openHour < closeHour <= 24", ErrInvalid)}
+	}
+	t, err := timeInHours(start, end, openHour, closeHour, r)
+	if err != nil {
+		return time.Time{}, &Error{fn, err}
+	}
+	return t, nil
+}
+
+// Duration function is used to get a random duration between a range [min, max].
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the randomly chosen duration of type time.Duration and any write error encountered.
+// example: random.Duration(100*time.Millisecond, 2*time.Second, nil), returns a duration like 1.283s.
+func Duration(min time.Duration, max time.Duration, r *rand.Rand) (time.Duration, error) {
+	const fn = "Duration"
+	if min >= max {
+		return 0, &Error{fn, ErrEndNumSmaller}
+	}
+	return time.Duration(int64Range(int64(min), int64(max), rng(r))), nil
+}
+
+// FullJitter function is used to get a random duration in the range [0, d], the "full jitter" of a backoff delay d.
+// It spreads the retries of many clients the most, at the cost of sometimes retrying almost at once.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// example: random.FullJitter(8*time.Second, nil), returns a duration like 5.31s.
+func FullJitter(d time.Duration, r *rand.Rand) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return time.Duration(int64Range(0, int64(d), rng(r)))
+}
+
+// EqualJitter function is used to get a random duration in the range [d/2, d], the "equal jitter" of a backoff delay d.
+// It keeps half of the delay and randomizes the other half.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// example: random.EqualJitter(8*time.Second, nil), returns a duration like 6.72s.
+func EqualJitter(d time.Duration, r *rand.Rand) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + FullJitter(d-d/2, r)
+}
+
+// DecorrelatedJitter function is used to get the next backoff delay with "decorrelated jitter",
+// a random duration in the range [base, 3*prev] capped at max.
+// The delay grows with the previous one instead of the number of attempts, pass base as prev for the first delay.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// example: random.DecorrelatedJitter(100*time.Millisecond, prev, 10*time.Second, nil), returns the delay to wait after prev.
+func DecorrelatedJitter(base time.Duration, prev time.Duration, max time.Duration, r *rand.Rand) time.Duration {
+	upper := max
+	if prev < max/3 {
+		upper = prev * 3
+	}
+	if upper <= base {
+		if base > max {
+			return max
+		}
+		return base
+	}
+	return time.Duration(int64Range(int64(base), int64(upper), rng(r)))
+}
+
+// PoissonArrivals function is used to get the arrival times of a Poisson process between a range [start, end).
+// The gaps between arrivals are exponentially distributed with a mean of mean, which models independent events
+// like incoming requests, so about end.Sub(start) / mean arrivals are returned.
+// r (type *rand.Rand) is the generator used, nil uses Default().
+// It returns the arrival times in increasing order in a slice of type []time.Time and any write error encountered.
+// example: random.PoissonArrivals(start, start.Add(time.Hour), time.Minute, nil), returns about 60 request times within an hour.
+func PoissonArrivals(start time.Time, end time.Time, mean time.Duration, r *rand.Rand) ([]time.Time, error) {
+	const fn = "PoissonArrivals"
+	if !end.After(start) {
+		return nil, &Error{fn, ErrEndNumSmaller}
+	}
+	if mean <= 0 {
+		return nil, &Error{fn, fmt.Errorf("%w: mean must be positive", ErrInvalid)}
+	}
+	r = rng(r)
+	var arrivals []time.Time
+	t := start
+	for {
+		gap := r.ExpFloat64() * float64(mean)
+		if gap >= float64(end.Sub(t)) {
+			return arrivals, nil
+		}
+		t = t.Add(time.Duration(gap))
+		arrivals = append(arrivals, t)
+	}
+}
+
+// timeInHours is one of the inner functions of this package.
+// It returns a random time between a range [start, end) which falls on a Monday to Friday between openHour and closeHour.
+// A closeHour of 24 means the following midnight, so days of 23 or 25 hours are handled.
+func timeInHours(start time.Time, end time.Time, openHour int, closeHour int, r *rand.Rand) (time.Time, error) {
+	if !end.After(start) {
+		return time.Time{}, ErrEndNumSmaller
+	}
+	if end.Sub(start) == math.MaxInt64 {
+		return time.Time{}, fmt.Errorf("%w: the range is too long", ErrInvalid)
+	}
+	type window struct{ from, to time.Time }
+	var windows []window
+	var total time.Duration
+	loc := start.Location()
+	y, m, d := start.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, loc); day.Before(end); day = time.Date(y, m, d, 0, 0, 0, 0, loc) {
+		d++
+		if wd := day.Weekday(); wd == time.Saturday || wd == time.Sunday {
+			continue
+		}
+		w := window{day.Add(time.Duration(openHour) * time.Hour), day.Add(time.Duration(closeHour) * time.Hour)}
+		if next := time.Date(y, m, d, 0, 0, 0, 0, loc); closeHour == 24 || w.to.After(next) {
+			w.to = next
+		}
+		if w.from.Before(start) {
+			w.from = start
+		}
+		if w.to.After(end) {
+			w.to = end
+		}
+		if w.to.After(w.from) {
+			windows = append(windows, w)
+			total += w.to.Sub(w.from)
+		}
+	}
+	if total == 0 {
+		return time.Time{}, ErrEmpty
+	}
+	offset := time.Duration(int64Range(0, int64(total)-1, rng(r)))
+	for _, w := range windows {
+		if offset < w.to.Sub(w.from) {
+			return w.from.Add(offset), nil
+		}
+		offset -= w.to.Sub(w.from)
+	}
+	return windows[len(windows)-1].to, nil
+}